@@ -0,0 +1,21 @@
+package httptransport
+
+import (
+	"io"
+	"net/http"
+)
+
+// DrainLimit is the upper bound on the amount of data read from a response
+// body which isn't returned to the caller. Longer bodies are not drained and
+// the connection is closed instead.
+const DrainLimit = 64 << 10
+
+// DiscardResponse reads up to [DrainLimit] bytes from the body of a response
+// which isn't returned to the caller and closes it, allowing the connection to
+// be reused.
+func DiscardResponse(resp *http.Response) {
+	if resp != nil && resp.Body != nil {
+		io.CopyN(io.Discard, resp.Body, DrainLimit)
+		resp.Body.Close()
+	}
+}
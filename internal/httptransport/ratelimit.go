@@ -0,0 +1,94 @@
+package httptransport
+
+import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Factor by which the rate can be reduced at most relative to the configured
+// limit.
+const rateLimitMinFactor = 32
+
+// RateLimitOptions configures the transport returned by [LimitRate].
+type RateLimitOptions struct {
+	// Maximum number of requests per second.
+	Limit float64
+
+	// Responses taking longer than the given duration to arrive temporarily
+	// reduce the rate. Zero disables the slowdown.
+	SlowThreshold time.Duration
+}
+
+type limitRate struct {
+	base    http.RoundTripper
+	opts    RateLimitOptions
+	limiter *rate.Limiter
+
+	mu      sync.Mutex
+	current float64
+}
+
+var _ http.RoundTripper = (*limitRate)(nil)
+
+// LimitRate returns an HTTP round-tripper permitting up to the configured
+// number of requests per second using a token bucket. The rate is halved
+// whenever the server responds with 429 Too Many Requests or a response takes
+// longer than the slow threshold. It gradually recovers with each timely
+// response. A limit of zero or less disables the limitation.
+func LimitRate(base http.RoundTripper, opts RateLimitOptions) http.RoundTripper {
+	if !(opts.Limit > 0) {
+		return base
+	}
+
+	burst := max(1, int(math.Ceil(opts.Limit)))
+
+	return &limitRate{
+		base:    base,
+		opts:    opts,
+		limiter: rate.NewLimiter(rate.Limit(opts.Limit), burst),
+		current: opts.Limit,
+	}
+}
+
+// adjust updates the current rate depending on whether the server appears to
+// be overloaded.
+func (l *limitRate) adjust(overloaded bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	next := l.current
+
+	if overloaded {
+		next = max(l.current/2, l.opts.Limit/rateLimitMinFactor)
+	} else {
+		// Additive increase to not immediately overwhelm the server again.
+		next = min(l.current+l.opts.Limit/rateLimitMinFactor, l.opts.Limit)
+	}
+
+	if next != l.current {
+		l.current = next
+		l.limiter.SetLimit(rate.Limit(next))
+	}
+}
+
+func (l *limitRate) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := l.limiter.Wait(r.Context()); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+
+	resp, err := l.base.RoundTrip(r)
+	if err != nil {
+		return resp, err
+	}
+
+	l.adjust(resp.StatusCode == http.StatusTooManyRequests ||
+		(l.opts.SlowThreshold > 0 && time.Since(start) > l.opts.SlowThreshold))
+
+	return resp, err
+}
@@ -0,0 +1,167 @@
+package httptransport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+type retryAllowedKey struct{}
+
+// WithRetryAllowed marks requests using the returned context as safe to
+// retry regardless of their method.
+func WithRetryAllowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryAllowedKey{}, true)
+}
+
+// RetryOptions configures the transport returned by [Retry].
+type RetryOptions struct {
+	// Maximum number of retries after the initial attempt.
+	MaxRetries int
+
+	// Maximum delay requested by a server via the Retry-After header. Longer
+	// delays are not honoured and the response is returned as-is.
+	MaxRetryAfter time.Duration
+
+	// Constructs the policy for delays between attempts.
+	NewBackOff func() backoff.BackOff
+
+	// Invoked before waiting for the next attempt. Optional.
+	Notify func(req *http.Request, err error, delay time.Duration)
+}
+
+type retry struct {
+	base http.RoundTripper
+	opts RetryOptions
+}
+
+var _ http.RoundTripper = (*retry)(nil)
+
+// Retry returns an HTTP round-tripper retrying requests which failed with
+// a network error, a server error (5xx) or due to rate limiting (429 Too Many
+// Requests). Only requests with an idempotent method or a context from
+// [WithRetryAllowed] are retried. A max of zero or less disables retries.
+func Retry(base http.RoundTripper, opts RetryOptions) http.RoundTripper {
+	if opts.MaxRetries < 1 {
+		return base
+	}
+
+	if opts.NewBackOff == nil {
+		opts.NewBackOff = func() backoff.BackOff {
+			return backoff.NewExponentialBackOff()
+		}
+	}
+
+	return &retry{
+		base: base,
+		opts: opts,
+	}
+}
+
+func retryAllowed(r *http.Request) bool {
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		// Body can't be sent again.
+		return false
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	allowed, _ := r.Context().Value(retryAllowedKey{}).(bool)
+
+	return allowed
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if ts, err := http.ParseTime(value); err == nil {
+		return max(0, ts.Sub(now)), true
+	}
+
+	return 0, false
+}
+
+func (t *retry) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !retryAllowed(r) {
+		return t.base.RoundTrip(r)
+	}
+
+	ctx := r.Context()
+	b := backoff.WithMaxRetries(t.opts.NewBackOff(), uint64(t.opts.MaxRetries))
+	attempt := r
+
+	for {
+		resp, err := t.base.RoundTrip(attempt)
+
+		if !shouldRetry(resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := b.NextBackOff()
+		if delay == backoff.Stop {
+			return resp, err
+		}
+
+		if err == nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				if t.opts.MaxRetryAfter > 0 && retryAfter > t.opts.MaxRetryAfter {
+					return resp, err
+				}
+
+				delay = retryAfter
+			}
+		}
+
+		if r.GetBody != nil {
+			body, bodyErr := r.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+
+			attempt = r.Clone(ctx)
+			attempt.Body = body
+		}
+
+		if err == nil {
+			err = fmt.Errorf("server responded with status %q", resp.Status)
+		}
+
+		DiscardResponse(resp)
+
+		if t.opts.Notify != nil {
+			t.opts.Notify(r, err, delay)
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// BulkDownloadContent selects the files included in a bulk download.
+type BulkDownloadContent string
+
+const (
+	// Archived versions where available, originals otherwise.
+	BulkDownloadArchive BulkDownloadContent = "archive"
+
+	// Original files only.
+	BulkDownloadOriginals BulkDownloadContent = "originals"
+
+	// Archived versions in an "archive" directory and original files in an
+	// "originals" directory.
+	BulkDownloadBoth BulkDownloadContent = "both"
+)
+
+// BulkDownloadCompression is the ZIP compression method. Only
+// [BulkDownloadCompressionNone] and [BulkDownloadCompressionDeflated] are
+// supported by [WalkBulkDownload].
+type BulkDownloadCompression string
+
+const (
+	BulkDownloadCompressionNone     BulkDownloadCompression = "none"
+	BulkDownloadCompressionDeflated BulkDownloadCompression = "deflated"
+	BulkDownloadCompressionBzip2    BulkDownloadCompression = "bzip2"
+	BulkDownloadCompressionLZMA     BulkDownloadCompression = "lzma"
+)
+
+type BulkDownloadOptions struct {
+	// Files to include; defaults to [BulkDownloadArchive].
+	Content BulkDownloadContent
+
+	// Compression method; defaults to [BulkDownloadCompressionNone].
+	Compression BulkDownloadCompression
+
+	// Name files according to their storage path instead of the title.
+	// Entries named this way can't be mapped to documents by
+	// [WalkBulkDownload].
+	FollowFormatting bool
+}
+
+// BulkDownloadDocuments retrieves multiple documents as a single ZIP archive
+// written to w.
+func (c *Client) BulkDownloadDocuments(ctx context.Context, w io.Writer, documents []int64, opts BulkDownloadOptions) (*DownloadResult, *Response, error) {
+	ctx = withOperation(ctx, "BulkDownloadDocuments")
+
+	if len(documents) == 0 {
+		return nil, nil, errors.New("bulk download requires at least one document")
+	}
+
+	body := map[string]any{
+		"documents":         documents,
+		"content":           BulkDownloadArchive,
+		"compression":       BulkDownloadCompressionNone,
+		"follow_formatting": opts.FollowFormatting,
+	}
+
+	if opts.Content != "" {
+		body["content"] = opts.Content
+	}
+
+	if opts.Compression != "" {
+		body["compression"] = opts.Compression
+	}
+
+	req := c.newRequest(ctx).SetBody(body)
+
+	return c.downloadRequest(w, req, resty.MethodPost, "api/documents/bulk_download/", true)
+}
+
+type BulkDownloadEntry struct {
+	File *zip.File
+
+	// ID of the document or zero if the entry couldn't be mapped.
+	DocumentID int64
+}
+
+// Files with the same name are disambiguated with a counter suffix, e.g.
+// "2024-01-02 Invoice_01.pdf".
+var bulkDownloadCounterRe = regexp.MustCompile(`^(.*)_(\d{2,})$`)
+
+func fileStem(name string) string {
+	name = path.Base(name)
+
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+// WalkBulkDownload invokes fn for each file in a ZIP archive produced by
+// [Client.BulkDownloadDocuments]. The archive doesn't contain document IDs.
+// Entries are mapped back to the given documents by comparing their names
+// with [Document.ArchivedFileName]. Documents without an archived version
+// can't be mapped. The server disambiguates documents with the same name with
+// a counter suffix in an order which can't be determined by the client.
+// Entries for such documents are therefore left unmapped.
+func WalkBulkDownload(r io.ReaderAt, size int64, documents []Document, fn func(BulkDownloadEntry) error) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+
+	byStem := map[string][]int64{}
+
+	for _, doc := range documents {
+		if doc.ArchivedFileName != nil && *doc.ArchivedFileName != "" {
+			stem := fileStem(*doc.ArchivedFileName)
+			byStem[stem] = append(byStem[stem], doc.ID)
+		}
+	}
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		entry := BulkDownloadEntry{File: f}
+
+		stem := fileStem(f.Name)
+
+		if ids := byStem[stem]; len(ids) == 1 {
+			entry.DocumentID = ids[0]
+		}
+
+		if m := bulkDownloadCounterRe.FindStringSubmatch(stem); m != nil && len(byStem[m[1]]) > 1 {
+			// Possibly a renamed duplicate of another document.
+			entry.DocumentID = 0
+		}
+
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
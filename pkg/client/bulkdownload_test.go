@@ -0,0 +1,223 @@
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func newTestZip(t *testing.T, names ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+
+	for _, name := range names {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := w.Write([]byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestBulkDownloadDocuments(t *testing.T) {
+	content := newTestZip(t, "2024-01-02 Invoice.pdf")
+
+	for _, tc := range []struct {
+		name      string
+		setup     func(*testing.T, *httpmock.MockTransport)
+		documents []int64
+		opts      BulkDownloadOptions
+		want      *DownloadResult
+		wantErr   error
+	}{
+		{
+			name: "defaults",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/bulk_download/",
+					newJSONBodyResponder(t, map[string]any{
+						"documents":         []int64{1, 2},
+						"content":           "archive",
+						"compression":       "none",
+						"follow_formatting": false,
+					}, httpmock.NewBytesResponder(http.StatusOK, content).HeaderSet(http.Header{
+						"Content-Type":        []string{"application/zip"},
+						"Content-Disposition": []string{`attachment; filename="documents.zip"`},
+					})))
+			},
+			documents: []int64{1, 2},
+			want: &DownloadResult{
+				ContentType: "application/zip",
+				Filename:    "documents.zip",
+				Length:      int64(len(content)),
+			},
+		},
+		{
+			name: "options",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/bulk_download/",
+					newJSONBodyResponder(t, map[string]any{
+						"documents":         []int64{3},
+						"content":           "both",
+						"compression":       "deflated",
+						"follow_formatting": true,
+					}, httpmock.NewBytesResponder(http.StatusOK, content).HeaderSet(http.Header{
+						"Content-Type": []string{"application/zip"},
+					})))
+			},
+			documents: []int64{3},
+			opts: BulkDownloadOptions{
+				Content:          BulkDownloadBoth,
+				Compression:      BulkDownloadCompressionDeflated,
+				FollowFormatting: true,
+			},
+			want: &DownloadResult{
+				ContentType: "application/zip",
+				Length:      int64(len(content)),
+			},
+		},
+		{
+			name:    "no documents",
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "error",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/bulk_download/",
+					httpmock.NewStringResponder(http.StatusForbidden, `{}`))
+			},
+			documents: []int64{4},
+			wantErr: &RequestError{
+				StatusCode: http.StatusForbidden,
+				Message:    `403 Forbidden`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			if tc.setup != nil {
+				tc.setup(t, transport)
+			}
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			var buf bytes.Buffer
+
+			got, _, err := c.BulkDownloadDocuments(context.Background(), &buf, tc.documents, tc.opts)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("BulkDownloadDocuments() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("BulkDownloadDocuments() result diff (-want +got):\n%s", diff)
+				}
+
+				if !bytes.Equal(buf.Bytes(), content) {
+					t.Errorf("BulkDownloadDocuments() wrote unexpected content")
+				}
+			}
+		})
+	}
+}
+
+func TestWalkBulkDownload(t *testing.T) {
+	documents := []Document{
+		{ID: 30, ArchivedFileName: String("2024-01-02 ACME Invoice.pdf")},
+		{ID: 12, ArchivedFileName: String("2024-01-02 ACME Invoice.pdf")},
+		{ID: 7, ArchivedFileName: String("2023-05-06 Letter_01.pdf")},
+		{ID: 8},
+		{ID: 9, ArchivedFileName: String("2024-02-03 Receipt.pdf")},
+	}
+
+	content := newTestZip(t,
+		"archive/2024-01-02 ACME Invoice.pdf",
+		"archive/2024-01-02 ACME Invoice_01.pdf",
+		"originals/2024-01-02 ACME Invoice.jpg",
+		"originals/2024-01-02 ACME Invoice_01.png",
+		"originals/2023-05-06 Letter_01.txt",
+		"originals/unknown.txt",
+		"originals/2024-01-02 ACME Invoice_05.png",
+		"archive/2024-02-03 Receipt.pdf",
+		"originals/2024-02-03 Receipt.jpg",
+	)
+
+	type entry struct {
+		Name       string
+		DocumentID int64
+	}
+
+	var got []entry
+
+	if err := WalkBulkDownload(bytes.NewReader(content), int64(len(content)), documents, func(e BulkDownloadEntry) error {
+		got = append(got, entry{e.File.Name, e.DocumentID})
+		return nil
+	}); err != nil {
+		t.Fatalf("WalkBulkDownload() failed: %v", err)
+	}
+
+	want := []entry{
+		{"archive/2024-01-02 ACME Invoice.pdf", 0},
+		{"archive/2024-01-02 ACME Invoice_01.pdf", 0},
+		{"originals/2024-01-02 ACME Invoice.jpg", 0},
+		{"originals/2024-01-02 ACME Invoice_01.png", 0},
+		{"originals/2023-05-06 Letter_01.txt", 7},
+		{"originals/unknown.txt", 0},
+		{"originals/2024-01-02 ACME Invoice_05.png", 0},
+		{"archive/2024-02-03 Receipt.pdf", 9},
+		{"originals/2024-02-03 Receipt.jpg", 9},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("WalkBulkDownload() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestWalkBulkDownloadError(t *testing.T) {
+	errTest := errors.New("test")
+
+	content := newTestZip(t, "a.pdf", "b.pdf")
+
+	var count int
+
+	err := WalkBulkDownload(bytes.NewReader(content), int64(len(content)), nil, func(BulkDownloadEntry) error {
+		count++
+		return errTest
+	})
+
+	if diff := cmp.Diff(errTest, err, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("WalkBulkDownload() error diff (-want +got):\n%s", diff)
+	}
+
+	if count != 1 {
+		t.Errorf("Callback invoked %d times, want 1", count)
+	}
+
+	if err := WalkBulkDownload(bytes.NewReader([]byte("garbage")), 7, nil, func(BulkDownloadEntry) error {
+		return nil
+	}); err == nil {
+		t.Errorf("WalkBulkDownload() succeeded on invalid archive")
+	}
+}
@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// BulkEditOperation is implemented by all operations supported by
+// [Client.BulkEditDocuments].
+type BulkEditOperation interface {
+	// Name of the bulk edit method and its parameters.
+	bulkEditMethod() (string, map[string]any, error)
+}
+
+// BulkEditAddTag adds a tag to all documents.
+type BulkEditAddTag struct {
+	Tag int64
+}
+
+var _ BulkEditOperation = (*BulkEditAddTag)(nil)
+
+func (o BulkEditAddTag) bulkEditMethod() (string, map[string]any, error) {
+	return "add_tag", map[string]any{"tag": o.Tag}, nil
+}
+
+// BulkEditRemoveTag removes a tag from all documents.
+type BulkEditRemoveTag struct {
+	Tag int64
+}
+
+var _ BulkEditOperation = (*BulkEditRemoveTag)(nil)
+
+func (o BulkEditRemoveTag) bulkEditMethod() (string, map[string]any, error) {
+	return "remove_tag", map[string]any{"tag": o.Tag}, nil
+}
+
+// BulkEditModifyTags adds and removes multiple tags at once.
+type BulkEditModifyTags struct {
+	Add    []int64
+	Remove []int64
+}
+
+var _ BulkEditOperation = (*BulkEditModifyTags)(nil)
+
+func (o BulkEditModifyTags) bulkEditMethod() (string, map[string]any, error) {
+	return "modify_tags", map[string]any{
+		"add_tags":    nonNilSlice(o.Add),
+		"remove_tags": nonNilSlice(o.Remove),
+	}, nil
+}
+
+// BulkEditSetCorrespondent sets the correspondent on all documents. A nil
+// correspondent removes the current one.
+type BulkEditSetCorrespondent struct {
+	Correspondent *int64
+}
+
+var _ BulkEditOperation = (*BulkEditSetCorrespondent)(nil)
+
+func (o BulkEditSetCorrespondent) bulkEditMethod() (string, map[string]any, error) {
+	return "set_correspondent", map[string]any{"correspondent": o.Correspondent}, nil
+}
+
+// BulkEditSetDocumentType sets the document type on all documents. A nil
+// document type removes the current one.
+type BulkEditSetDocumentType struct {
+	DocumentType *int64
+}
+
+var _ BulkEditOperation = (*BulkEditSetDocumentType)(nil)
+
+func (o BulkEditSetDocumentType) bulkEditMethod() (string, map[string]any, error) {
+	return "set_document_type", map[string]any{"document_type": o.DocumentType}, nil
+}
+
+// BulkEditSetStoragePath sets the storage path on all documents. A nil
+// storage path removes the current one.
+type BulkEditSetStoragePath struct {
+	StoragePath *int64
+}
+
+var _ BulkEditOperation = (*BulkEditSetStoragePath)(nil)
+
+func (o BulkEditSetStoragePath) bulkEditMethod() (string, map[string]any, error) {
+	return "set_storage_path", map[string]any{"storage_path": o.StoragePath}, nil
+}
+
+// BulkEditModifyCustomFields adds and removes custom fields. Added fields
+// have no value.
+type BulkEditModifyCustomFields struct {
+	// IDs of custom fields to add.
+	Add []int64
+
+	// IDs of custom fields to remove.
+	Remove []int64
+}
+
+var _ BulkEditOperation = (*BulkEditModifyCustomFields)(nil)
+
+func (o BulkEditModifyCustomFields) bulkEditMethod() (string, map[string]any, error) {
+	return "modify_custom_fields", map[string]any{
+		"add_custom_fields":    nonNilSlice(o.Add),
+		"remove_custom_fields": nonNilSlice(o.Remove),
+	}, nil
+}
+
+// BulkEditSetPermissions changes the owner and/or the object-level
+// permissions of all documents.
+type BulkEditSetPermissions struct {
+	// New owner; nil removes the owner.
+	Owner *int64
+
+	// Object-level permissions to apply. Left unchanged if nil.
+	Permissions *ObjectPermissions
+
+	// Merge the given permissions with the existing ones instead of replacing
+	// them. The owner is only changed if non-nil.
+	Merge bool
+}
+
+var _ BulkEditOperation = (*BulkEditSetPermissions)(nil)
+
+func (o BulkEditSetPermissions) bulkEditMethod() (string, map[string]any, error) {
+	params := map[string]any{
+		"owner": o.Owner,
+		"merge": o.Merge,
+	}
+
+	if o.Permissions != nil {
+		params["set_permissions"] = o.Permissions
+	}
+
+	return "set_permissions", params, nil
+}
+
+// BulkEditDelete deletes all documents.
+type BulkEditDelete struct{}
+
+var _ BulkEditOperation = (*BulkEditDelete)(nil)
+
+func (BulkEditDelete) bulkEditMethod() (string, map[string]any, error) {
+	return "delete", map[string]any{}, nil
+}
+
+// BulkEditReprocess starts the consumption process for all documents again.
+type BulkEditReprocess struct{}
+
+var _ BulkEditOperation = (*BulkEditReprocess)(nil)
+
+func (BulkEditReprocess) bulkEditMethod() (string, map[string]any, error) {
+	return "reprocess", map[string]any{}, nil
+}
+
+func nonNilSlice[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+
+	return s
+}
+
+type BulkEditResult struct {
+	// Result reported by the server, usually "OK".
+	Result string `json:"result"`
+}
+
+// BulkEditDocuments applies an operation to all given documents at once.
+// Depending on the operation the modification may be executed asynchronously
+// without a way to observe its completion. Use
+// [Client.WaitForCreatedDocuments] for operations creating new documents.
+func (c *Client) BulkEditDocuments(ctx context.Context, documents []int64, op BulkEditOperation) (*BulkEditResult, *Response, error) {
+	ctx = withOperation(ctx, "BulkEditDocuments")
+
+	if len(documents) == 0 {
+		return nil, nil, errors.New("bulk edit requires at least one document")
+	}
+
+	method, params, err := op.bulkEditMethod()
+	if err != nil {
+		return nil, nil, fmt.Errorf("bulk edit %s: %w", method, err)
+	}
+
+	resp, err := c.newRequest(ctx).
+		SetResult(&BulkEditResult{}).
+		SetBody(map[string]any{
+			"documents":  documents,
+			"method":     method,
+			"parameters": params,
+		}).
+		Post("api/documents/bulk_edit/")
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	return resp.Result().(*BulkEditResult), wrapResponse(resp), nil
+}
@@ -0,0 +1,249 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func newJSONBodyResponder(t *testing.T, want any, responder httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		var got any
+
+		if err := json.NewDecoder(req.Body).Decode(&got); err != nil {
+			t.Errorf("Decoding request body failed: %v", err)
+		}
+
+		// Normalize expected value to the types produced by the JSON decoder.
+		if buf, err := json.Marshal(want); err != nil {
+			t.Errorf("Marshal() failed: %v", err)
+		} else if err := json.Unmarshal(buf, &want); err != nil {
+			t.Errorf("Unmarshal() failed: %v", err)
+		}
+
+		if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("Request body diff (-want +got):\n%s", diff)
+		}
+
+		return responder(req)
+	}
+}
+
+func TestBulkEditDocuments(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		documents []int64
+		op        BulkEditOperation
+		wantBody  map[string]any
+		response  string
+		want      *BulkEditResult
+		wantErr   error
+	}{
+		{
+			name:      "add tag",
+			documents: []int64{1, 2, 3},
+			op:        BulkEditAddTag{Tag: 10},
+			wantBody: map[string]any{
+				"documents":  []int64{1, 2, 3},
+				"method":     "add_tag",
+				"parameters": map[string]any{"tag": 10},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "remove tag",
+			documents: []int64{4},
+			op:        BulkEditRemoveTag{Tag: 20},
+			wantBody: map[string]any{
+				"documents":  []int64{4},
+				"method":     "remove_tag",
+				"parameters": map[string]any{"tag": 20},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "modify tags",
+			documents: []int64{5},
+			op:        BulkEditModifyTags{Add: []int64{1, 2}},
+			wantBody: map[string]any{
+				"documents": []int64{5},
+				"method":    "modify_tags",
+				"parameters": map[string]any{
+					"add_tags":    []int64{1, 2},
+					"remove_tags": []int64{},
+				},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "unset correspondent",
+			documents: []int64{6},
+			op:        BulkEditSetCorrespondent{},
+			wantBody: map[string]any{
+				"documents":  []int64{6},
+				"method":     "set_correspondent",
+				"parameters": map[string]any{"correspondent": nil},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "set document type",
+			documents: []int64{7},
+			op:        BulkEditSetDocumentType{DocumentType: Int64(3)},
+			wantBody: map[string]any{
+				"documents":  []int64{7},
+				"method":     "set_document_type",
+				"parameters": map[string]any{"document_type": 3},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "set storage path",
+			documents: []int64{8},
+			op:        BulkEditSetStoragePath{StoragePath: Int64(4)},
+			wantBody: map[string]any{
+				"documents":  []int64{8},
+				"method":     "set_storage_path",
+				"parameters": map[string]any{"storage_path": 4},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "modify custom fields",
+			documents: []int64{9},
+			op:        BulkEditModifyCustomFields{Remove: []int64{11}},
+			wantBody: map[string]any{
+				"documents": []int64{9},
+				"method":    "modify_custom_fields",
+				"parameters": map[string]any{
+					"add_custom_fields":    []int64{},
+					"remove_custom_fields": []int64{11},
+				},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "set permissions",
+			documents: []int64{10},
+			op: BulkEditSetPermissions{
+				Owner: Int64(2),
+				Permissions: &ObjectPermissions{
+					View: ObjectPermissionPrincipals{
+						Users: []int64{3},
+					},
+				},
+				Merge: true,
+			},
+			wantBody: map[string]any{
+				"documents": []int64{10},
+				"method":    "set_permissions",
+				"parameters": map[string]any{
+					"owner": 2,
+					"merge": true,
+					"set_permissions": map[string]any{
+						"view": map[string]any{
+							"users":  []int64{3},
+							"groups": nil,
+						},
+						"change": map[string]any{
+							"users":  nil,
+							"groups": nil,
+						},
+					},
+				},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "delete",
+			documents: []int64{11, 12},
+			op:        BulkEditDelete{},
+			wantBody: map[string]any{
+				"documents":  []int64{11, 12},
+				"method":     "delete",
+				"parameters": map[string]any{},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:      "reprocess",
+			documents: []int64{13},
+			op:        BulkEditReprocess{},
+			wantBody: map[string]any{
+				"documents":  []int64{13},
+				"method":     "reprocess",
+				"parameters": map[string]any{},
+			},
+			response: `{"result": "OK"}`,
+			want:     &BulkEditResult{Result: "OK"},
+		},
+		{
+			name:    "no documents",
+			op:      BulkEditDelete{},
+			wantErr: cmpopts.AnyError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			if tc.wantBody != nil {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/bulk_edit/",
+					newJSONBodyResponder(t, tc.wantBody,
+						httpmock.NewStringResponder(http.StatusOK, tc.response)))
+			}
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			got, _, err := c.BulkEditDocuments(context.Background(), tc.documents, tc.op)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("BulkEditDocuments() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("BulkEditDocuments() result diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBulkEditDocumentsError(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodPost, "/api/documents/bulk_edit/",
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"documents": ["Some documents don't exist"]}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	_, _, err := c.BulkEditDocuments(context.Background(), []int64{1}, BulkEditAddTag{Tag: 1})
+
+	wantErr := &RequestError{
+		StatusCode: http.StatusBadRequest,
+		Message:    `{"documents":["Some documents don't exist"]}`,
+	}
+
+	if diff := cmp.Diff(wantErr, err, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("BulkEditDocuments() error diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// DocumentCheckpoint records the progress of a crawl over documents. It can
+// be persisted, e.g. as JSON, and used to resume the crawl with
+// [Client.IterAllDocumentsFrom].
+type DocumentCheckpoint struct {
+	// Whether documents are crawled in descending order. Must match the
+	// ordering given to [Client.IterAllDocumentsFrom].
+	Desc bool `json:"desc"`
+
+	// Time at which the last processed document was added.
+	LastAdded time.Time `json:"last_added"`
+
+	// ID of the last document processed. Zero starts from the beginning.
+	LastID int64 `json:"last_id"`
+}
+
+// Update records a document as processed.
+func (cp *DocumentCheckpoint) Update(doc Document) {
+	cp.LastAdded = doc.Added
+	cp.LastID = doc.ID
+}
+
+// follows returns whether the document comes after the recorded position in
+// crawl order.
+func (cp DocumentCheckpoint) follows(doc Document) bool {
+	if cp.LastID == 0 {
+		return true
+	}
+
+	result := doc.Added.Compare(cp.LastAdded)
+
+	if result == 0 {
+		result = cmp.Compare(doc.ID, cp.LastID)
+	}
+
+	if cp.Desc {
+		result = -result
+	}
+
+	return result > 0
+}
+
+// seek restricts the options to documents at or after the recorded position.
+// Documents added at the same time as the last one are included as well.
+func (cp DocumentCheckpoint) seek(o *ListDocumentsOptions) {
+	if cp.LastID == 0 {
+		return
+	}
+
+	ts := cp.LastAdded
+
+	if cp.Desc {
+		if o.Added.Lte == nil || ts.Before(*o.Added.Lte) {
+			o.Added.Lte = &ts
+		}
+	} else if o.Added.Gte == nil || ts.After(*o.Added.Gte) {
+		o.Added.Gte = &ts
+	}
+}
+
+// IterAllDocumentsFrom returns an iterator over all documents matching the
+// filters specified in opts and following the document recorded in the
+// checkpoint.
+//
+// Instead of page numbers the position within the result set is tracked using
+// the time the last document was added and its ID. Documents added or removed
+// during the crawl don't cause others to be skipped or returned twice.
+// Documents are therefore always ordered by the time they were added with ties
+// broken by ID. The only supported orderings are ascending and descending by
+// "added" (the default is ascending) and the direction must match the
+// checkpoint. The page number in opts is ignored.
+//
+// When more than a page of documents was added at the same time the following
+// pages are requested by number. Documents removed concurrently from such
+// a group may cause others of the group to be skipped.
+//
+// An error is returned when a page doesn't advance the position, e.g. when
+// the server ignores the filter, instead of requesting the same page forever.
+func (c *Client) IterAllDocumentsFrom(ctx context.Context, opts ListDocumentsOptions, from DocumentCheckpoint) iter.Seq2[Document, error] {
+	ctx = withOperation(ctx, "IterAllDocumentsFrom")
+
+	var err error
+
+	switch {
+	case opts.Ordering.Field != "" && opts.Ordering.Field != "added":
+		err = fmt.Errorf("resumable iteration requires ordering by time added, not %q", opts.Ordering.Field)
+
+	case opts.Ordering.Desc != from.Desc:
+		err = errors.New("ordering direction doesn't match the checkpoint")
+	}
+
+	if err != nil {
+		return func(yield func(Document, error) bool) {
+			yield(Document{}, err)
+		}
+	}
+
+	// Break ties by ID. The direction prefix is added by OrderingSpec.
+	opts.Ordering.Field = "added,id"
+
+	if from.Desc {
+		opts.Ordering.Field = "added,-id"
+	}
+
+	_, size := opts.Page.values()
+
+	opts.Page = &PageToken{number: 1, size: size}
+
+	from.seek(&opts)
+
+	pos := from
+
+	docs := crudIterate(ctx, c.documentCrudOpts(), opts, func(o *ListDocumentsOptions, items []Document, resp *Response) (bool, error) {
+		if resp == nil || resp.NextPage == nil || len(items) == 0 {
+			return false, nil
+		}
+
+		last := items[len(items)-1]
+
+		if !pos.follows(last) {
+			return false, fmt.Errorf("page of documents didn't advance past document %d; the server may not support the filter or ordering", pos.LastID)
+		}
+
+		// The time filter can't narrow the result set when all documents on
+		// the page were added at the same time as the previous position.
+		sameTime := pos.LastID != 0 && last.Added.Equal(pos.LastAdded)
+
+		pos.Update(last)
+
+		page := 1
+
+		if sameTime {
+			page = o.Page.number + 1
+		} else {
+			pos.seek(o)
+		}
+
+		o.Page = &PageToken{number: page, size: size}
+
+		return true, nil
+	})
+
+	return func(yield func(Document, error) bool) {
+		for doc, err := range docs {
+			if err == nil && !from.follows(doc) {
+				// Added at the same time as the checkpoint document.
+				continue
+			}
+
+			if !yield(doc, err) {
+				return
+			}
+		}
+	}
+}
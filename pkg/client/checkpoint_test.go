@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestIterAllDocumentsFrom(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		setup     func(*testing.T, *httpmock.MockTransport)
+		opts      ListDocumentsOptions
+		from      DocumentCheckpoint
+		want      []int64
+		wantErr   error
+		wantCalls int
+	}{
+		{
+			name: "from start",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"ordering=added,id&page=1&page_size=2",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"next": "?page=2",
+						"results": [
+							{ "id": 7, "added": "2024-03-01T10:00:00.5Z" },
+							{ "id": 3, "added": "2024-03-01T12:00:00.25Z" }
+						]
+					}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__gte=2024-03-01T12:00:00.25Z&ordering=added,id&page=1&page_size=2",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"results": [
+							{ "id": 3, "added": "2024-03-01T12:00:00.25Z" },
+							{ "id": 12, "added": "2024-03-01T12:00:00.25Z" }
+						]
+					}`))
+			},
+			opts: ListDocumentsOptions{
+				ListOptions: ListOptions{
+					Page: &PageToken{number: 8, size: 2},
+				},
+			},
+			want:      []int64{7, 3, 12},
+			wantCalls: 2,
+		},
+		{
+			name: "resume",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__gte=2024-03-01T12:00:00.25Z&ordering=added,id&page=1&page_size=25&title__icontains=x",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"next": "?page=2",
+						"results": [
+							{ "id": 2, "added": "2024-03-01T12:00:00.25Z" },
+							{ "id": 7, "added": "2024-03-01T12:00:00.25Z" },
+							{ "id": 4, "added": "2024-03-01T12:00:00.75Z" }
+						]
+					}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__gte=2024-03-01T12:00:00.75Z&ordering=added,id&page=1&page_size=25&title__icontains=x",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"results": [{ "id": 4, "added": "2024-03-01T12:00:00.75Z" }]
+					}`))
+			},
+			opts: ListDocumentsOptions{
+				Title: CharFilterSpec{ContainsIgnoringCase: String("x")},
+			},
+			from: DocumentCheckpoint{
+				LastAdded: time.Date(2024, time.March, 1, 12, 0, 0, 250_000_000, time.UTC),
+				LastID:    2,
+			},
+			want:      []int64{7, 4},
+			wantCalls: 2,
+		},
+		{
+			name: "resume descending",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__lte=2024-03-01T12:00:00Z&ordering=-added,-id&page=1&page_size=25",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"next": "?page=2",
+						"results": [
+							{ "id": 50, "added": "2024-03-01T12:00:00Z" },
+							{ "id": 40, "added": "2024-03-01T12:00:00Z" },
+							{ "id": 39, "added": "2024-02-01T00:00:00Z" }
+						]
+					}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__lte=2024-02-01T00:00:00Z&ordering=-added,-id&page=1&page_size=25",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"results": [{ "id": 1, "added": "2024-01-01T00:00:00Z" }]
+					}`))
+			},
+			opts: ListDocumentsOptions{
+				Ordering: OrderingSpec{Field: "added", Desc: true},
+			},
+			from: DocumentCheckpoint{
+				Desc:      true,
+				LastAdded: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+				LastID:    50,
+			},
+			want: []int64{40, 39, 1},
+		},
+		{
+			name: "same time exceeding page",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"ordering=added,id&page=1&page_size=2",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"next": "?page=2",
+						"results": [
+							{ "id": 1, "added": "2024-01-01T00:00:00Z" },
+							{ "id": 2, "added": "2024-03-01T12:00:00Z" }
+						]
+					}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__gte=2024-03-01T12:00:00Z&ordering=added,id&page=1&page_size=2",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"next": "?page=2",
+						"results": [
+							{ "id": 2, "added": "2024-03-01T12:00:00Z" },
+							{ "id": 3, "added": "2024-03-01T12:00:00Z" }
+						]
+					}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__gte=2024-03-01T12:00:00Z&ordering=added,id&page=2&page_size=2",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"next": "?page=3",
+						"results": [
+							{ "id": 4, "added": "2024-03-01T12:00:00Z" },
+							{ "id": 5, "added": "2024-03-02T00:00:00Z" }
+						]
+					}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+					"added__gte=2024-03-02T00:00:00Z&ordering=added,id&page=1&page_size=2",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"results": [{ "id": 5, "added": "2024-03-02T00:00:00Z" }]
+					}`))
+			},
+			opts: ListDocumentsOptions{
+				ListOptions: ListOptions{
+					Page: &PageToken{size: 2},
+				},
+			},
+			want:      []int64{1, 2, 3, 4, 5},
+			wantCalls: 4,
+		},
+		{
+			name: "filter ignored",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"next": "?page=2",
+						"results": [
+							{ "id": 1, "added": "2024-01-01T00:00:00Z" },
+							{ "id": 2, "added": "2024-01-02T00:00:00Z" }
+						]
+					}`))
+			},
+			wantErr:   cmpopts.AnyError,
+			wantCalls: 2,
+		},
+		{
+			name: "unsupported ordering",
+			opts: ListDocumentsOptions{
+				Ordering: OrderingSpec{Field: "id"},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "direction mismatch",
+			opts: ListDocumentsOptions{
+				Ordering: OrderingSpec{Field: "added"},
+			},
+			from: DocumentCheckpoint{
+				Desc:   true,
+				LastID: 50,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			if tc.setup != nil {
+				tc.setup(t, transport)
+			}
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			var got []int64
+			var err error
+
+			cp := tc.from
+
+			for doc, iterErr := range c.IterAllDocumentsFrom(context.Background(), tc.opts, tc.from) {
+				if iterErr != nil {
+					err = iterErr
+					break
+				}
+
+				got = append(got, doc.ID)
+
+				cp.Update(doc)
+			}
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("IterAllDocumentsFrom() error diff (-want +got):\n%s", diff)
+			}
+
+			if tc.wantCalls > 0 {
+				if got := transport.GetTotalCallCount(); got != tc.wantCalls {
+					t.Errorf("Got %d calls, want %d", got, tc.wantCalls)
+				}
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("IterAllDocumentsFrom() diff (-want +got):\n%s", diff)
+				}
+
+				if len(tc.want) > 0 && cp.LastID != tc.want[len(tc.want)-1] {
+					t.Errorf("Checkpoint has last ID %d, want %d", cp.LastID, tc.want[len(tc.want)-1])
+				}
+			}
+		})
+	}
+}
+
+func TestDocumentCheckpointJSON(t *testing.T) {
+	want := DocumentCheckpoint{
+		Desc:      true,
+		LastAdded: time.Date(2024, time.March, 1, 12, 0, 0, 250_000_000, time.UTC),
+		LastID:    1234,
+	}
+
+	buf, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal() failed: %v", err)
+	}
+
+	if diff := cmp.Diff(`{"desc":true,"last_added":"2024-03-01T12:00:00.25Z","last_id":1234}`, string(buf)); diff != "" {
+		t.Errorf("Marshal() diff (-want +got):\n%s", diff)
+	}
+
+	var got DocumentCheckpoint
+
+	if err := json.Unmarshal(buf, &got); err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unmarshal() diff (-want +got):\n%s", diff)
+	}
+}
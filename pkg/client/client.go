@@ -5,11 +5,14 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"log"
+	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hansmi/paperhooks/internal/httptransport"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const ItemCountUnknown = -1
@@ -30,6 +33,10 @@ type Options struct {
 	// logger (log.Default()).
 	Logger Logger
 
+	// Structured logger for writing log messages. Takes precedence over
+	// Logger.
+	SlogLogger *slog.Logger
+
 	// HTTP headers to set on all requests.
 	Header http.Header
 
@@ -41,11 +48,32 @@ type Options struct {
 	// (no limitation).
 	MaxConcurrentRequests int
 
+	// Maximum number of requests per second. Defaults to zero (no
+	// limitation). The rate is reduced temporarily when the server responds
+	// with 429 Too Many Requests.
+	MaxRequestsPerSecond float64
+
+	// Responses taking longer than the given duration to arrive temporarily
+	// reduce the request rate. Only effective in combination with
+	// MaxRequestsPerSecond. Defaults to zero (disabled).
+	SlowResponseThreshold time.Duration
+
+	// Automatic retries of failed requests. Disabled by default.
+	Retry RetryPolicy
+
 	// TrustedRootCAs defines the set of certificate authorities the client
 	// uses when verifying server certificates. If nil the system's default
 	// certificate pool is used.
 	TrustedRootCAs *x509.CertPool
 
+	// OpenTelemetry provider for creating a span per API call. Tracing is
+	// disabled if nil.
+	TracerProvider trace.TracerProvider
+
+	// OpenTelemetry provider for request duration and error metrics. Metrics
+	// are disabled if nil.
+	MeterProvider metric.MeterProvider
+
 	// Override the default HTTP transport.
 	transport http.RoundTripper
 }
@@ -54,11 +82,16 @@ type Client struct {
 	logger Logger
 	loc    *time.Location
 	r      *resty.Client
+
+	// Client without authentication.
+	anon *resty.Client
 }
 
 // New creates a new client instance.
 func New(opts Options) *Client {
-	if opts.Logger == nil {
+	if opts.SlogLogger != nil {
+		opts.Logger = NewSlogLogger(opts.SlogLogger.Handler())
+	} else if opts.Logger == nil {
 		if opts.DebugMode {
 			opts.Logger = &wrappedStdLogger{log.Default()}
 		} else {
@@ -70,6 +103,73 @@ func New(opts Options) *Client {
 		opts.ServerLocation = time.Local
 	}
 
+	// Share links are accessed without credentials.
+	anonOpts := opts
+	anonOpts.Auth = nil
+
+	tel := newTelemetry(opts)
+
+	r := newRestyClient(opts, tel)
+	anon := newRestyClient(anonOpts, tel)
+
+	// Both clients share the limitations so they apply to the client as
+	// a whole. Requests made by the anonymous client are dispatched to its own
+	// transport once they've passed the limits.
+	anon.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		req.SetContext(context.WithValue(req.Context(), anonymousRequestKey{}, true))
+		return nil
+	})
+
+	limited := newLimitedTransport(opts, &anonymousSwitch{
+		auth: r.GetClient().Transport,
+		anon: anon.GetClient().Transport,
+	})
+
+	r.SetTransport(limited)
+	anon.SetTransport(limited)
+
+	return &Client{
+		logger: opts.Logger,
+		loc:    opts.ServerLocation,
+		r:      r,
+		anon:   anon,
+	}
+}
+
+type anonymousRequestKey struct{}
+
+// anonymousSwitch sends requests made by the anonymous client via
+// a transport without authentication.
+type anonymousSwitch struct {
+	auth http.RoundTripper
+	anon http.RoundTripper
+}
+
+var _ http.RoundTripper = (*anonymousSwitch)(nil)
+
+func (s *anonymousSwitch) RoundTrip(r *http.Request) (*http.Response, error) {
+	if anonymous, _ := r.Context().Value(anonymousRequestKey{}).(bool); anonymous {
+		return s.anon.RoundTrip(r)
+	}
+
+	return s.auth.RoundTrip(r)
+}
+
+// newLimitedTransport applies the limitations specific to the Paperless API.
+func newLimitedTransport(opts Options, base http.RoundTripper) http.RoundTripper {
+	// Waiting for a retry or the rate limit must not occupy a concurrency
+	// slot. Every attempt counts towards the rate limit.
+	return opts.Retry.wrap(
+		httptransport.LimitRate(
+			httptransport.LimitConcurrent(base, opts.MaxConcurrentRequests),
+			httptransport.RateLimitOptions{
+				Limit:         opts.MaxRequestsPerSecond,
+				SlowThreshold: opts.SlowResponseThreshold,
+			}),
+		opts.Logger)
+}
+
+func newRestyClient(opts Options, tel *telemetry) *resty.Client {
 	r := resty.New().
 		SetDebug(opts.DebugMode).
 		SetLogger(&prefixLogger{
@@ -85,13 +185,6 @@ func New(opts Options) *Client {
 		r.SetTransport(opts.transport)
 	}
 
-	if opts.Auth != nil {
-		// Authentication may use or modify the transport (e.g. OAuth), so it
-		// must be set up before applying limitations specific to the Paperless
-		// API.
-		opts.Auth.authenticate(opts, r)
-	}
-
 	if opts.TrustedRootCAs != nil {
 		// TODO: Resty v3 has Client.TLSClientConfig and
 		// Client.SetTLSClientConfig functions.
@@ -113,7 +206,16 @@ func New(opts Options) *Client {
 		r.SetTransport(transport)
 	}
 
-	r.SetTransport(httptransport.LimitConcurrent(r.GetClient().Transport, opts.MaxConcurrentRequests))
+	if opts.Auth != nil {
+		// Authentication may use or wrap the transport (e.g. OAuth), so it
+		// must be set up after configuring TLS and before applying
+		// limitations specific to the Paperless API (see New).
+		opts.Auth.authenticate(opts, r)
+	}
+
+	if tel != nil {
+		tel.register(r)
+	}
 
 	if len(opts.Header) > 0 {
 		r.SetPreRequestHook(func(_ *resty.Client, req *http.Request) error {
@@ -125,11 +227,7 @@ func New(opts Options) *Client {
 		})
 	}
 
-	return &Client{
-		logger: opts.Logger,
-		loc:    opts.ServerLocation,
-		r:      r,
-	}
+	return r
 }
 
 func (c *Client) newRequest(ctx context.Context) *resty.Request {
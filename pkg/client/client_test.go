@@ -8,7 +8,9 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-cmp/cmp"
@@ -181,3 +183,79 @@ func TestWrapResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestClientRateLimit(t *testing.T) {
+	var calls atomic.Int64
+
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodGet, "/api/",
+		func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+
+			return httpmock.NewJsonResponse(http.StatusOK, nil)
+		})
+
+	c := New(Options{
+		transport:             transport,
+		MaxConcurrentRequests: 1,
+		MaxRequestsPerSecond:  0.1,
+	})
+
+	if err := c.Ping(t.Context()); err != nil {
+		t.Fatalf("Ping() failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	err := c.Ping(ctx)
+
+	if diff := cmp.Diff(context.Canceled, err, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("Ping() error diff (-want +got):\n%s", diff)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Got %d calls, want 1", got)
+	}
+}
+
+func TestClientRateLimitSharedWithShareLinks(t *testing.T) {
+	var calls atomic.Int64
+
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodGet, "/api/",
+		func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+
+			return httpmock.NewJsonResponse(http.StatusOK, nil)
+		})
+	transport.RegisterResponder(http.MethodGet, "/share/abc",
+		func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+
+			return httpmock.NewStringResponse(http.StatusOK, "content"), nil
+		})
+
+	c := New(Options{
+		transport:            transport,
+		Auth:                 &TokenAuth{Token: "secret"},
+		MaxRequestsPerSecond: 0.1,
+	})
+
+	if err := c.Ping(t.Context()); err != nil {
+		t.Fatalf("Ping() failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	_, _, err := c.DownloadShareLink(ctx, io.Discard, "abc")
+
+	if diff := cmp.Diff(context.Canceled, err, cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("DownloadShareLink() error diff (-want +got):\n%s", diff)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Got %d calls, want 1", got)
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 )
 
 func (c *Client) correspondentCrudOpts() crudOptions {
@@ -21,36 +22,59 @@ type ListCorrespondentsOptions struct {
 	ListOptions
 
 	Ordering OrderingSpec   `url:"ordering"`
+	ID       IDFilterSpec   `url:"id"`
 	Owner    IntFilterSpec  `url:"owner"`
 	Name     CharFilterSpec `url:"name"`
 }
 
 func (c *Client) ListCorrespondents(ctx context.Context, opts ListCorrespondentsOptions) ([]Correspondent, *Response, error) {
+	ctx = withOperation(ctx, "ListCorrespondents")
+
 	return crudList[Correspondent](ctx, c.correspondentCrudOpts(), opts)
 }
 
 // ListAllCorrespondents iterates over all correspondents matching the filters
 // specified in opts, invoking handler for each.
 func (c *Client) ListAllCorrespondents(ctx context.Context, opts ListCorrespondentsOptions, handler func(context.Context, Correspondent) error) error {
+	ctx = withOperation(ctx, "ListAllCorrespondents")
+
 	return crudListAll[Correspondent](ctx, c.correspondentCrudOpts(), opts, handler)
 }
 
+// IterAllCorrespondents returns an iterator over all correspondents matching
+// the filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllCorrespondents(ctx context.Context, opts ListCorrespondentsOptions) iter.Seq2[Correspondent, error] {
+	ctx = withOperation(ctx, "IterAllCorrespondents")
+
+	return crudIterAll[Correspondent](ctx, c.correspondentCrudOpts(), opts)
+}
+
 func (c *Client) GetCorrespondent(ctx context.Context, id int64) (*Correspondent, *Response, error) {
+	ctx = withOperation(ctx, "GetCorrespondent")
+
 	return crudGet[Correspondent](ctx, c.correspondentCrudOpts(), id)
 }
 
 func (c *Client) CreateCorrespondent(ctx context.Context, data *CorrespondentFields) (*Correspondent, *Response, error) {
+	ctx = withOperation(ctx, "CreateCorrespondent")
+
 	return crudCreate[Correspondent](ctx, c.correspondentCrudOpts(), data)
 }
 
 func (c *Client) UpdateCorrespondent(ctx context.Context, id int64, data *Correspondent) (*Correspondent, *Response, error) {
+	ctx = withOperation(ctx, "UpdateCorrespondent")
+
 	return crudUpdate[Correspondent](ctx, c.correspondentCrudOpts(), id, data)
 }
 
 func (c *Client) PatchCorrespondent(ctx context.Context, id int64, data *CorrespondentFields) (*Correspondent, *Response, error) {
+	ctx = withOperation(ctx, "PatchCorrespondent")
+
 	return crudPatch[Correspondent](ctx, c.correspondentCrudOpts(), id, data)
 }
 
 func (c *Client) DeleteCorrespondent(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteCorrespondent")
+
 	return crudDelete[Correspondent](ctx, c.correspondentCrudOpts(), id)
 }
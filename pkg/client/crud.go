@@ -4,12 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strconv"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-querystring/query"
-	"golang.org/x/sync/errgroup"
 )
 
 type listResult[T any] struct {
@@ -25,6 +25,16 @@ type listResult[T any] struct {
 	Items []T `json:"results"`
 }
 
+func (r *listResult[T]) itemCount() (int64, bool) {
+	if r.Count == nil {
+		return 0, false
+	}
+
+	count, err := r.Count.Int64()
+
+	return count, err == nil
+}
+
 type crudOptions struct {
 	newRequest func(context.Context) *resty.Request
 	base       string
@@ -85,36 +95,64 @@ func crudList[T, O any](ctx context.Context, opts crudOptions, listOpts O) ([]T,
 	return results.Items, w, nil
 }
 
-func crudListAll[T, O any](ctx context.Context, opts crudOptions, listOpts O, handler func(context.Context, T) error) error {
-	queue := make(chan []T, 2)
+// crudIterate returns an iterator over items. Pages are fetched ahead of the
+// consumer in a separate goroutine. After each page next updates listOpts for
+// the following page and returns false when there are no more or an error if
+// iteration can't continue. Fetching stops as soon as the consumer stops
+// iterating. A failure is reported as the last element of the sequence.
+func crudIterate[T, O any](ctx context.Context, opts crudOptions, listOpts O, next func(*O, []T, *Response) (bool, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
 
-	g, ctx := errgroup.WithContext(ctx)
-	g.Go(func() error {
-		defer close(queue)
+		queue := make(chan []T, 2)
 
-		for {
-			items, resp, err := crudList[T](ctx, opts, listOpts)
-			if err != nil {
-				return err
-			}
+		var fetchErr error
 
-			select {
-			case queue <- items:
-			case <-ctx.Done():
-				return ctx.Err()
-			}
+		go func() {
+			defer close(queue)
+
+			// Every iteration starts from the original options.
+			listOpts := listOpts
 
-			if resp == nil || resp.NextPage == nil {
-				break
+			for {
+				if err := ctx.Err(); err != nil {
+					// The consumer may have stopped while the page was being
+					// queued.
+					fetchErr = err
+					return
+				}
+
+				items, resp, err := crudList[T](ctx, opts, listOpts)
+				if err != nil {
+					fetchErr = err
+					return
+				}
+
+				select {
+				case queue <- items:
+				case <-ctx.Done():
+					fetchErr = ctx.Err()
+					return
+				}
+
+				if more, err := next(&listOpts, items, resp); err != nil {
+					fetchErr = err
+					return
+				} else if !more {
+					return
+				}
 			}
+		}()
 
-			opts.setPage(&listOpts, resp.NextPage)
-		}
+		stop := func() {
+			cancel()
 
-		return nil
-	})
+			// Wait for the producer to terminate.
+			for range queue {
+			}
+		}
 
-	g.Go(func() error {
 		seen := map[int64]struct{}{}
 
 		for items := range queue {
@@ -129,16 +167,48 @@ func crudListAll[T, O any](ctx context.Context, opts crudOptions, listOpts O, ha
 
 				seen[key] = struct{}{}
 
-				if err := handler(ctx, i); err != nil {
-					return err
+				if !yield(i, nil) {
+					stop()
+					return
 				}
 			}
 		}
 
-		return nil
+		// The queue is closed after fetchErr is set.
+		if fetchErr != nil {
+			var zero T
+
+			yield(zero, fetchErr)
+		}
+	}
+}
+
+// crudIterAll returns an iterator over all items matching listOpts by
+// following the next page tokens.
+func crudIterAll[T, O any](ctx context.Context, opts crudOptions, listOpts O) iter.Seq2[T, error] {
+	return crudIterate(ctx, opts, listOpts, func(o *O, _ []T, resp *Response) (bool, error) {
+		if resp == nil || resp.NextPage == nil {
+			return false, nil
+		}
+
+		opts.setPage(o, resp.NextPage)
+
+		return true, nil
 	})
+}
+
+func crudListAll[T, O any](ctx context.Context, opts crudOptions, listOpts O, handler func(context.Context, T) error) error {
+	for i, err := range crudIterAll[T](ctx, opts, listOpts) {
+		if err != nil {
+			return err
+		}
+
+		if err := handler(withoutOperation(ctx), i); err != nil {
+			return err
+		}
+	}
 
-	return g.Wait()
+	return nil
 }
 
 func crudGet[T any](ctx context.Context, opts crudOptions, id int64) (*T, *Response, error) {
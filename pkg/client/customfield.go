@@ -2,8 +2,57 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"iter"
 )
 
+// CustomFieldDataType is the type of values stored in a custom field.
+type CustomFieldDataType string
+
+const (
+	CustomFieldTypeString       CustomFieldDataType = "string"
+	CustomFieldTypeURL          CustomFieldDataType = "url"
+	CustomFieldTypeDate         CustomFieldDataType = "date"
+	CustomFieldTypeBoolean      CustomFieldDataType = "boolean"
+	CustomFieldTypeInteger      CustomFieldDataType = "integer"
+	CustomFieldTypeFloat        CustomFieldDataType = "float"
+	CustomFieldTypeMonetary     CustomFieldDataType = "monetary"
+	CustomFieldTypeDocumentLink CustomFieldDataType = "documentlink"
+	CustomFieldTypeSelect       CustomFieldDataType = "select"
+	CustomFieldTypeLongText     CustomFieldDataType = "longtext"
+)
+
+// CustomFieldSelectOption is a choice of a select custom field.
+type CustomFieldSelectOption struct {
+	// Identifier stored as the field value. Empty for servers storing the
+	// option index instead (Paperless before 2.14).
+	ID    string `json:"id,omitempty"`
+	Label string `json:"label"`
+}
+
+var _ json.Unmarshaler = (*CustomFieldSelectOption)(nil)
+
+func (o *CustomFieldSelectOption) UnmarshalJSON(data []byte) error {
+	var label string
+
+	if err := json.Unmarshal(data, &label); err == nil {
+		*o = CustomFieldSelectOption{Label: label}
+		return nil
+	}
+
+	type plain CustomFieldSelectOption
+
+	return json.Unmarshal(data, (*plain)(o))
+}
+
+type CustomFieldExtraData struct {
+	// Choices for select fields.
+	SelectOptions []CustomFieldSelectOption `json:"select_options,omitempty"`
+
+	// ISO 4217 currency code used for monetary values without currency.
+	DefaultCurrency *string `json:"default_currency,omitempty"`
+}
+
 func (c *Client) customFieldCrudOpts() crudOptions {
 	return crudOptions{
 		base:       "api/custom_fields/",
@@ -22,35 +71,60 @@ type ListCustomFieldsOptions struct {
 }
 
 func (c *Client) ListCustomFields(ctx context.Context, opts ListCustomFieldsOptions) ([]CustomField, *Response, error) {
+	ctx = withOperation(ctx, "ListCustomFields")
+
 	return crudList[CustomField](ctx, c.customFieldCrudOpts(), opts)
 }
 
 // ListAllCustomFields iterates over all custom fields matching the filters
 // specified in opts, invoking handler for each.
 func (c *Client) ListAllCustomFields(ctx context.Context, opts ListCustomFieldsOptions, handler func(context.Context, CustomField) error) error {
+	ctx = withOperation(ctx, "ListAllCustomFields")
+
 	return crudListAll[CustomField](ctx, c.customFieldCrudOpts(), opts, handler)
 }
 
+// IterAllCustomFields returns an iterator over all custom fields matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllCustomFields(ctx context.Context, opts ListCustomFieldsOptions) iter.Seq2[CustomField, error] {
+	ctx = withOperation(ctx, "IterAllCustomFields")
+
+	return crudIterAll[CustomField](ctx, c.customFieldCrudOpts(), opts)
+}
+
 func (c *Client) GetCustomField(ctx context.Context, id int64) (*CustomField, *Response, error) {
+	ctx = withOperation(ctx, "GetCustomField")
+
 	return crudGet[CustomField](ctx, c.customFieldCrudOpts(), id)
 }
 
 func (c *Client) CreateCustomField(ctx context.Context, data *CustomFieldFields) (*CustomField, *Response, error) {
+	ctx = withOperation(ctx, "CreateCustomField")
+
 	return crudCreate[CustomField](ctx, c.customFieldCrudOpts(), data)
 }
 
 func (c *Client) UpdateCustomField(ctx context.Context, id int64, data *CustomField) (*CustomField, *Response, error) {
+	ctx = withOperation(ctx, "UpdateCustomField")
+
 	return crudUpdate[CustomField](ctx, c.customFieldCrudOpts(), id, data)
 }
 
 func (c *Client) PatchCustomField(ctx context.Context, id int64, data *CustomFieldFields) (*CustomField, *Response, error) {
+	ctx = withOperation(ctx, "PatchCustomField")
+
 	return crudPatch[CustomField](ctx, c.customFieldCrudOpts(), id, data)
 }
 
 func (c *Client) DeleteCustomField(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteCustomField")
+
 	return crudDelete[CustomField](ctx, c.customFieldCrudOpts(), id)
 }
 
+// CustomFieldInstance is the value of a custom field on a document. Use the
+// typed constructors (e.g. [NewCustomFieldString]) and accessors (e.g.
+// [CustomFieldInstance.StringValue]) instead of setting Value directly.
 type CustomFieldInstance struct {
 	Field int64 `json:"field"`
 	Value any   `json:"value"`
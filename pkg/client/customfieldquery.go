@@ -0,0 +1,232 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Limits enforced by the server.
+const (
+	customFieldQueryMaxDepth = 10
+	customFieldQueryMaxAtoms = 20
+)
+
+// CustomFieldQueryOperator compares a custom field with a value.
+type CustomFieldQueryOperator string
+
+const (
+	CustomFieldOpExact       CustomFieldQueryOperator = "exact"
+	CustomFieldOpIn          CustomFieldQueryOperator = "in"
+	CustomFieldOpIsNull      CustomFieldQueryOperator = "isnull"
+	CustomFieldOpExists      CustomFieldQueryOperator = "exists"
+	CustomFieldOpIContains   CustomFieldQueryOperator = "icontains"
+	CustomFieldOpIStartsWith CustomFieldQueryOperator = "istartswith"
+	CustomFieldOpIEndsWith   CustomFieldQueryOperator = "iendswith"
+	CustomFieldOpGt          CustomFieldQueryOperator = "gt"
+	CustomFieldOpGte         CustomFieldQueryOperator = "gte"
+	CustomFieldOpLt          CustomFieldQueryOperator = "lt"
+	CustomFieldOpLte         CustomFieldQueryOperator = "lte"
+	CustomFieldOpRange       CustomFieldQueryOperator = "range"
+	CustomFieldOpContains    CustomFieldQueryOperator = "contains"
+)
+
+// CustomFieldRef identifies a custom field by ID or name.
+type CustomFieldRef struct {
+	id   int64
+	name string
+}
+
+func CustomFieldID(id int64) CustomFieldRef {
+	return CustomFieldRef{id: id}
+}
+
+func CustomFieldName(name string) CustomFieldRef {
+	return CustomFieldRef{name: name}
+}
+
+func (r CustomFieldRef) value() any {
+	if r.name != "" {
+		return r.name
+	}
+
+	return r.id
+}
+
+// CustomFieldQuery is an expression for filtering documents by custom field
+// values. The zero value doesn't filter. Expressions are validated before
+// being sent to the server.
+//
+//	q := CustomFieldQueryAnd(
+//		NewCustomFieldQuery(CustomFieldName("Amount"), CustomFieldOpRange, []float64{10, 100}),
+//		CustomFieldQueryNot(NewCustomFieldQuery(CustomFieldID(3), CustomFieldOpExists, true)),
+//	)
+type CustomFieldQuery struct {
+	// Logical operator ("AND", "OR" or "NOT"); empty for comparisons.
+	logical  string
+	children []CustomFieldQuery
+
+	field CustomFieldRef
+	op    CustomFieldQueryOperator
+	value any
+}
+
+var _ json.Marshaler = (*CustomFieldQuery)(nil)
+var _ query.Encoder = (*CustomFieldQuery)(nil)
+
+// NewCustomFieldQuery compares a custom field with a value.
+func NewCustomFieldQuery(field CustomFieldRef, op CustomFieldQueryOperator, value any) CustomFieldQuery {
+	return CustomFieldQuery{field: field, op: op, value: value}
+}
+
+// CustomFieldQueryAnd matches documents matching all given expressions.
+func CustomFieldQueryAnd(children ...CustomFieldQuery) CustomFieldQuery {
+	return CustomFieldQuery{logical: "AND", children: children}
+}
+
+// CustomFieldQueryOr matches documents matching any of the given expressions.
+func CustomFieldQueryOr(children ...CustomFieldQuery) CustomFieldQuery {
+	return CustomFieldQuery{logical: "OR", children: children}
+}
+
+// CustomFieldQueryNot inverts an expression.
+func CustomFieldQueryNot(child CustomFieldQuery) CustomFieldQuery {
+	return CustomFieldQuery{logical: "NOT", children: []CustomFieldQuery{child}}
+}
+
+// IsZero returns whether the query is empty.
+func (q CustomFieldQuery) IsZero() bool {
+	return q.logical == "" && q.op == "" && q.children == nil && q.value == nil && q.field == (CustomFieldRef{})
+}
+
+func isListValue(value any) bool {
+	if value == nil {
+		return false
+	}
+
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Slice, reflect.Array:
+		return true
+	}
+
+	return false
+}
+
+func (q CustomFieldQuery) validateAtom() error {
+	if q.field.id <= 0 && q.field.name == "" {
+		return errors.New("missing custom field")
+	}
+
+	var valid bool
+
+	switch q.op {
+	case CustomFieldOpExact:
+		valid = true
+
+	case CustomFieldOpIsNull, CustomFieldOpExists:
+		_, valid = q.value.(bool)
+
+	case CustomFieldOpIContains, CustomFieldOpIStartsWith, CustomFieldOpIEndsWith:
+		_, valid = q.value.(string)
+
+	case CustomFieldOpGt, CustomFieldOpGte, CustomFieldOpLt, CustomFieldOpLte:
+		_, isBool := q.value.(bool)
+		valid = !(q.value == nil || isBool || isListValue(q.value))
+
+	case CustomFieldOpIn, CustomFieldOpContains:
+		valid = isListValue(q.value)
+
+	case CustomFieldOpRange:
+		valid = isListValue(q.value) && reflect.ValueOf(q.value).Len() == 2
+
+	default:
+		return fmt.Errorf("unsupported operator %q", q.op)
+	}
+
+	if !valid {
+		return fmt.Errorf("invalid value %#v for operator %q", q.value, q.op)
+	}
+
+	return nil
+}
+
+func (q CustomFieldQuery) validate(depth int, atoms *int) error {
+	if depth > customFieldQueryMaxDepth {
+		return fmt.Errorf("maximum nesting depth of %d exceeded", customFieldQueryMaxDepth)
+	}
+
+	switch q.logical {
+	case "":
+		if *atoms++; *atoms > customFieldQueryMaxAtoms {
+			return fmt.Errorf("maximum number of %d comparisons exceeded", customFieldQueryMaxAtoms)
+		}
+
+		return q.validateAtom()
+
+	case "AND", "OR":
+		if len(q.children) == 0 {
+			return fmt.Errorf("%s requires at least one expression", q.logical)
+		}
+
+	case "NOT":
+		if len(q.children) != 1 {
+			return errors.New("NOT requires exactly one expression")
+		}
+	}
+
+	for _, child := range q.children {
+		if err := child.validate(depth+1, atoms); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks whether the expression is well-formed.
+func (q CustomFieldQuery) Validate() error {
+	var atoms int
+
+	if err := q.validate(1, &atoms); err != nil {
+		return fmt.Errorf("custom field query: %w", err)
+	}
+
+	return nil
+}
+
+func (q CustomFieldQuery) MarshalJSON() ([]byte, error) {
+	switch q.logical {
+	case "":
+		return json.Marshal([]any{q.field.value(), q.op, q.value})
+
+	case "NOT":
+		if len(q.children) == 1 {
+			return json.Marshal([]any{q.logical, q.children[0]})
+		}
+	}
+
+	return json.Marshal([]any{q.logical, nonNilSlice(q.children)})
+}
+
+func (q CustomFieldQuery) EncodeValues(key string, v *url.Values) error {
+	if q.IsZero() {
+		return nil
+	}
+
+	if err := q.Validate(); err != nil {
+		return err
+	}
+
+	buf, err := json.Marshal(q)
+	if err != nil {
+		return err
+	}
+
+	v.Set(key, string(buf))
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/go-querystring/query"
+)
+
+func TestCustomFieldQueryEncode(t *testing.T) {
+	type fake struct {
+		Query CustomFieldQuery `url:"custom_field_query"`
+	}
+
+	nested := NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpExists, true)
+
+	for range customFieldQueryMaxDepth - 1 {
+		nested = CustomFieldQueryNot(nested)
+	}
+
+	var manyAtoms []CustomFieldQuery
+
+	for range customFieldQueryMaxAtoms + 1 {
+		manyAtoms = append(manyAtoms, NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpExact, "x"))
+	}
+
+	for _, tc := range []struct {
+		name    string
+		query   CustomFieldQuery
+		want    url.Values
+		wantErr error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "exact by name",
+			query: NewCustomFieldQuery(CustomFieldName("Invoice number"), CustomFieldOpExact, "R-100"),
+			want: url.Values{
+				"custom_field_query": []string{`["Invoice number","exact","R-100"]`},
+			},
+		},
+		{
+			name: "nested",
+			query: CustomFieldQueryAnd(
+				NewCustomFieldQuery(CustomFieldID(4), CustomFieldOpRange, []float64{10, 99.5}),
+				CustomFieldQueryOr(
+					NewCustomFieldQuery(CustomFieldName("Status"), CustomFieldOpIn, []string{"a1", "b2"}),
+					CustomFieldQueryNot(NewCustomFieldQuery(CustomFieldID(5), CustomFieldOpIsNull, true)),
+				),
+				NewCustomFieldQuery(CustomFieldID(6), CustomFieldOpGte, "2024-01-01"),
+				NewCustomFieldQuery(CustomFieldID(7), CustomFieldOpContains, []int64{12}),
+				NewCustomFieldQuery(CustomFieldID(8), CustomFieldOpIStartsWith, "inv"),
+			),
+			want: url.Values{
+				"custom_field_query": []string{
+					`["AND",[[4,"range",[10,99.5]],["OR",[["Status","in",["a1","b2"]],["NOT",[5,"isnull",true]]]],[6,"gte","2024-01-01"],[7,"contains",[12]],[8,"istartswith","inv"]]]`,
+				},
+			},
+		},
+		{
+			name:  "maximum depth",
+			query: nested,
+			want: url.Values{
+				"custom_field_query": []string{`["NOT",["NOT",["NOT",["NOT",["NOT",["NOT",["NOT",["NOT",["NOT",[1,"exists",true]]]]]]]]]]`},
+			},
+		},
+		{
+			name:    "too deep",
+			query:   CustomFieldQueryNot(nested),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "too many atoms",
+			query:   CustomFieldQueryOr(manyAtoms...),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "empty AND",
+			query:   CustomFieldQueryAnd(),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "missing field",
+			query:   NewCustomFieldQuery(CustomFieldRef{}, CustomFieldOpExact, 1),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "unknown operator",
+			query:   NewCustomFieldQuery(CustomFieldID(1), "between", 1),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "range arity",
+			query:   NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpRange, []int{1, 2, 3}),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "in without list",
+			query:   NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpIn, "a"),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "exists without bool",
+			query:   NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpExists, "yes"),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "comparison with list",
+			query:   NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpLt, []int{1}),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:    "icontains with number",
+			query:   NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpIContains, 1),
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "invalid child",
+			query: CustomFieldQueryAnd(
+				NewCustomFieldQuery(CustomFieldID(1), CustomFieldOpExact, 1),
+				NewCustomFieldQuery(CustomFieldID(2), CustomFieldOpRange, nil),
+			),
+			wantErr: cmpopts.AnyError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := query.Values(fake{tc.query})
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Values() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Values() diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrCustomFieldNull is returned by the typed accessors of
+// [CustomFieldInstance] when the field has no value.
+var ErrCustomFieldNull = errors.New("custom field has no value")
+
+// Maximum length of values of string custom fields.
+const customFieldStringMaxLength = 128
+
+// Monetary values consist of an optional ISO 4217 currency code and an amount
+// with up to two decimal places, e.g. "EUR12.50".
+var customFieldMonetaryRe = regexp.MustCompile(`^([A-Z]{3})?(-?\d+(?:\.\d{1,2})?)$`)
+
+func NewCustomFieldString(field int64, value string) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: value}
+}
+
+func NewCustomFieldURL(field int64, value string) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: value}
+}
+
+// NewCustomFieldDate constructs a date value. Only the date in the location
+// of the given time is used.
+func NewCustomFieldDate(field int64, value time.Time) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: value.Format(time.DateOnly)}
+}
+
+func NewCustomFieldBoolean(field int64, value bool) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: value}
+}
+
+func NewCustomFieldInteger(field int64, value int64) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: value}
+}
+
+func NewCustomFieldFloat(field int64, value float64) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: value}
+}
+
+// NewCustomFieldMonetary constructs a monetary value. The amount is rounded
+// to two decimal places. The currency is an ISO 4217 code such as "EUR" and
+// may be empty to use the field's default currency.
+func NewCustomFieldMonetary(field int64, currency string, amount float64) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: fmt.Sprintf("%s%.2f", currency, amount)}
+}
+
+// NewCustomFieldDocumentLink constructs a value linking to other documents.
+func NewCustomFieldDocumentLink(field int64, documents []int64) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: nonNilSlice(documents)}
+}
+
+// NewCustomFieldSelect constructs a value for a select field from the ID of
+// a [CustomFieldSelectOption].
+func NewCustomFieldSelect(field int64, optionID string) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: optionID}
+}
+
+func NewCustomFieldLongText(field int64, value string) CustomFieldInstance {
+	return CustomFieldInstance{Field: field, Value: value}
+}
+
+// NewCustomFieldNull constructs an instance without value.
+func NewCustomFieldNull(field int64) CustomFieldInstance {
+	return CustomFieldInstance{Field: field}
+}
+
+// IsNull returns whether the field has no value.
+func (i CustomFieldInstance) IsNull() bool {
+	return i.Value == nil
+}
+
+func (i CustomFieldInstance) typeError(want string) error {
+	return fmt.Errorf("custom field %d: expected %s value, got %T", i.Field, want, i.Value)
+}
+
+// StringValue returns the value of string, URL, long text and select fields.
+func (i CustomFieldInstance) StringValue() (string, error) {
+	switch v := i.Value.(type) {
+	case nil:
+		return "", ErrCustomFieldNull
+	case string:
+		return v, nil
+	}
+
+	return "", i.typeError("string")
+}
+
+// DateValue returns the value of a date field in the given location.
+func (i CustomFieldInstance) DateValue(loc *time.Location) (time.Time, error) {
+	s, err := i.StringValue()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.ParseInLocation(time.DateOnly, s, loc)
+}
+
+func (i CustomFieldInstance) BoolValue() (bool, error) {
+	switch v := i.Value.(type) {
+	case nil:
+		return false, ErrCustomFieldNull
+	case bool:
+		return v, nil
+	}
+
+	return false, i.typeError("boolean")
+}
+
+func (i CustomFieldInstance) FloatValue() (float64, error) {
+	switch v := i.Value.(type) {
+	case nil:
+		return 0, ErrCustomFieldNull
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	}
+
+	return 0, i.typeError("numeric")
+}
+
+func (i CustomFieldInstance) IntegerValue() (int64, error) {
+	switch v := i.Value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	}
+
+	f, err := i.FloatValue()
+	if err != nil {
+		return 0, err
+	}
+
+	if f != math.Trunc(f) || f < math.MinInt64 || f > math.MaxInt64 {
+		return 0, fmt.Errorf("custom field %d: %v is not an integer", i.Field, f)
+	}
+
+	return int64(f), nil
+}
+
+// MonetaryValue returns the currency and amount of a monetary field. The
+// currency is empty when the field's default currency applies.
+func (i CustomFieldInstance) MonetaryValue() (string, float64, error) {
+	switch i.Value.(type) {
+	case float64, int64, int:
+		// Values stored before currencies were supported.
+		amount, err := i.FloatValue()
+		return "", amount, err
+	}
+
+	s, err := i.StringValue()
+	if err != nil {
+		return "", 0, err
+	}
+
+	m := customFieldMonetaryRe.FindStringSubmatch(s)
+	if m == nil {
+		return "", 0, fmt.Errorf("custom field %d: invalid monetary value %q", i.Field, s)
+	}
+
+	amount, err := strconv.ParseFloat(m[2], 64)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return m[1], amount, nil
+}
+
+// DocumentLinkValue returns the document IDs of a document link field.
+func (i CustomFieldInstance) DocumentLinkValue() ([]int64, error) {
+	var values []any
+
+	switch v := i.Value.(type) {
+	case nil:
+		return nil, ErrCustomFieldNull
+	case []int64:
+		return v, nil
+	case []any:
+		values = v
+	default:
+		return nil, i.typeError("list")
+	}
+
+	result := make([]int64, 0, len(values))
+
+	for _, v := range values {
+		id, err := CustomFieldInstance{Field: i.Field, Value: v}.IntegerValue()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+// SelectValue returns the selected option ID of a select field. Servers
+// storing the option index report the index as a string.
+func (i CustomFieldInstance) SelectValue() (string, error) {
+	switch i.Value.(type) {
+	case float64, int64, int:
+		idx, err := i.IntegerValue()
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatInt(idx, 10), nil
+	}
+
+	return i.StringValue()
+}
+
+// Validate checks whether the value is compatible with the data type of the
+// given field.
+func (i CustomFieldInstance) Validate(field *CustomField) error {
+	if field.ID != i.Field {
+		return fmt.Errorf("custom field %d: value is for field %d", field.ID, i.Field)
+	}
+
+	if i.IsNull() {
+		return nil
+	}
+
+	var err error
+
+	switch field.DataType {
+	case CustomFieldTypeString:
+		var s string
+
+		if s, err = i.StringValue(); err == nil && utf8.RuneCountInString(s) > customFieldStringMaxLength {
+			err = fmt.Errorf("custom field %d: string exceeds %d characters", i.Field, customFieldStringMaxLength)
+		}
+
+	case CustomFieldTypeLongText:
+		_, err = i.StringValue()
+
+	case CustomFieldTypeURL:
+		var s string
+
+		if s, err = i.StringValue(); err == nil {
+			if u, parseErr := url.Parse(s); parseErr != nil || u.Scheme == "" || u.Host == "" {
+				err = fmt.Errorf("custom field %d: invalid URL %q", i.Field, s)
+			}
+		}
+
+	case CustomFieldTypeDate:
+		_, err = i.DateValue(time.UTC)
+
+	case CustomFieldTypeBoolean:
+		_, err = i.BoolValue()
+
+	case CustomFieldTypeInteger:
+		var n int64
+
+		if n, err = i.IntegerValue(); err == nil && (n < math.MinInt32 || n > math.MaxInt32) {
+			err = fmt.Errorf("custom field %d: integer %d out of range", i.Field, n)
+		}
+
+	case CustomFieldTypeFloat:
+		_, err = i.FloatValue()
+
+	case CustomFieldTypeMonetary:
+		_, _, err = i.MonetaryValue()
+
+	case CustomFieldTypeDocumentLink:
+		_, err = i.DocumentLinkValue()
+
+	case CustomFieldTypeSelect:
+		err = i.validateSelect(field.ExtraData)
+
+	default:
+		err = fmt.Errorf("custom field %d: unknown data type %q", i.Field, field.DataType)
+	}
+
+	return err
+}
+
+func (i CustomFieldInstance) validateSelect(extra *CustomFieldExtraData) error {
+	var options []CustomFieldSelectOption
+
+	if extra != nil {
+		options = extra.SelectOptions
+	}
+
+	if len(options) > 0 && options[0].ID == "" {
+		// Older servers store the option index.
+		idx, err := i.IntegerValue()
+		if err != nil {
+			return err
+		}
+
+		if idx < 0 || idx >= int64(len(options)) {
+			return fmt.Errorf("custom field %d: option index %d out of range", i.Field, idx)
+		}
+
+		return nil
+	}
+
+	id, err := i.StringValue()
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(options, func(o CustomFieldSelectOption) bool {
+		return o.ID == id
+	}) {
+		return fmt.Errorf("custom field %d: unknown option %q", i.Field, id)
+	}
+
+	return nil
+}
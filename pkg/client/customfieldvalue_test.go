@@ -0,0 +1,272 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// roundTripCustomField encodes and decodes a value like it would be when sent
+// to and received from the server.
+func roundTripCustomField(t *testing.T, i CustomFieldInstance) CustomFieldInstance {
+	t.Helper()
+
+	buf, err := json.Marshal(i)
+	if err != nil {
+		t.Fatalf("Marshal() failed: %v", err)
+	}
+
+	var result CustomFieldInstance
+
+	if err := json.Unmarshal(buf, &result); err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+
+	return result
+}
+
+func TestCustomFieldInstanceAccessors(t *testing.T) {
+	loc := time.FixedZone("Test", 3*60*60)
+
+	for _, tc := range []struct {
+		name  string
+		input CustomFieldInstance
+		get   func(CustomFieldInstance) (any, error)
+		want  any
+	}{
+		{
+			name:  "string",
+			input: NewCustomFieldString(1, "hello"),
+			get:   func(i CustomFieldInstance) (any, error) { return i.StringValue() },
+			want:  "hello",
+		},
+		{
+			name:  "url",
+			input: NewCustomFieldURL(1, "https://example.com/"),
+			get:   func(i CustomFieldInstance) (any, error) { return i.StringValue() },
+			want:  "https://example.com/",
+		},
+		{
+			name:  "date",
+			input: NewCustomFieldDate(1, time.Date(2024, time.April, 5, 23, 0, 0, 0, loc)),
+			get:   func(i CustomFieldInstance) (any, error) { return i.DateValue(loc) },
+			want:  time.Date(2024, time.April, 5, 0, 0, 0, 0, loc),
+		},
+		{
+			name:  "boolean",
+			input: NewCustomFieldBoolean(1, true),
+			get:   func(i CustomFieldInstance) (any, error) { return i.BoolValue() },
+			want:  true,
+		},
+		{
+			name:  "integer",
+			input: NewCustomFieldInteger(1, -42),
+			get:   func(i CustomFieldInstance) (any, error) { return i.IntegerValue() },
+			want:  int64(-42),
+		},
+		{
+			name:  "float",
+			input: NewCustomFieldFloat(1, 3.25),
+			get:   func(i CustomFieldInstance) (any, error) { return i.FloatValue() },
+			want:  3.25,
+		},
+		{
+			name:  "monetary",
+			input: NewCustomFieldMonetary(1, "EUR", 12.5),
+			get: func(i CustomFieldInstance) (any, error) {
+				currency, amount, err := i.MonetaryValue()
+				return []any{currency, amount}, err
+			},
+			want: []any{"EUR", 12.5},
+		},
+		{
+			name:  "monetary default currency",
+			input: NewCustomFieldMonetary(1, "", -3),
+			get: func(i CustomFieldInstance) (any, error) {
+				currency, amount, err := i.MonetaryValue()
+				return []any{currency, amount}, err
+			},
+			want: []any{"", -3.0},
+		},
+		{
+			name:  "document link",
+			input: NewCustomFieldDocumentLink(1, []int64{7, 9}),
+			get:   func(i CustomFieldInstance) (any, error) { return i.DocumentLinkValue() },
+			want:  []int64{7, 9},
+		},
+		{
+			name:  "empty document link",
+			input: NewCustomFieldDocumentLink(1, nil),
+			get:   func(i CustomFieldInstance) (any, error) { return i.DocumentLinkValue() },
+			want:  []int64{},
+		},
+		{
+			name:  "select",
+			input: NewCustomFieldSelect(1, "a1b2c3"),
+			get:   func(i CustomFieldInstance) (any, error) { return i.SelectValue() },
+			want:  "a1b2c3",
+		},
+		{
+			name:  "select index",
+			input: CustomFieldInstance{Field: 1, Value: 2},
+			get:   func(i CustomFieldInstance) (any, error) { return i.SelectValue() },
+			want:  "2",
+		},
+		{
+			name:  "long text",
+			input: NewCustomFieldLongText(1, "line 1\nline 2"),
+			get:   func(i CustomFieldInstance) (any, error) { return i.StringValue() },
+			want:  "line 1\nline 2",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.get(roundTripCustomField(t, tc.input))
+			if err != nil {
+				t.Fatalf("Accessor failed: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Accessor diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomFieldInstanceAccessorErrors(t *testing.T) {
+	null := NewCustomFieldNull(1)
+
+	if !null.IsNull() {
+		t.Errorf("IsNull() = false, want true")
+	}
+
+	if _, err := null.StringValue(); err != ErrCustomFieldNull {
+		t.Errorf("StringValue() error = %v, want %v", err, ErrCustomFieldNull)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		input CustomFieldInstance
+		get   func(CustomFieldInstance) error
+	}{
+		{
+			name:  "string from number",
+			input: NewCustomFieldInteger(1, 1),
+			get:   func(i CustomFieldInstance) (err error) { _, err = i.StringValue(); return },
+		},
+		{
+			name:  "integer from fraction",
+			input: NewCustomFieldFloat(1, 1.5),
+			get:   func(i CustomFieldInstance) (err error) { _, err = i.IntegerValue(); return },
+		},
+		{
+			name:  "bad monetary",
+			input: NewCustomFieldString(1, "12 EUR"),
+			get:   func(i CustomFieldInstance) (err error) { _, _, err = i.MonetaryValue(); return },
+		},
+		{
+			name:  "bad document link",
+			input: CustomFieldInstance{Field: 1, Value: []any{"x"}},
+			get:   func(i CustomFieldInstance) (err error) { _, err = i.DocumentLinkValue(); return },
+		},
+		{
+			name:  "bad date",
+			input: NewCustomFieldString(1, "yesterday"),
+			get:   func(i CustomFieldInstance) (err error) { _, err = i.DateValue(time.UTC); return },
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.get(roundTripCustomField(t, tc.input)); err == nil {
+				t.Errorf("Accessor succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestCustomFieldInstanceValidate(t *testing.T) {
+	selectField := &CustomField{
+		ID:       1,
+		DataType: CustomFieldTypeSelect,
+		ExtraData: &CustomFieldExtraData{
+			SelectOptions: []CustomFieldSelectOption{
+				{ID: "opt1", Label: "One"},
+				{ID: "opt2", Label: "Two"},
+			},
+		},
+	}
+
+	legacySelectField := &CustomField{
+		ID:       1,
+		DataType: CustomFieldTypeSelect,
+		ExtraData: &CustomFieldExtraData{
+			SelectOptions: []CustomFieldSelectOption{
+				{Label: "One"},
+				{Label: "Two"},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		field   *CustomField
+		value   CustomFieldInstance
+		wantErr bool
+	}{
+		{"null", &CustomField{ID: 1, DataType: CustomFieldTypeInteger}, NewCustomFieldNull(1), false},
+		{"wrong field", &CustomField{ID: 2, DataType: CustomFieldTypeString}, NewCustomFieldString(1, ""), true},
+		{"string", &CustomField{ID: 1, DataType: CustomFieldTypeString}, NewCustomFieldString(1, "abc"), false},
+		{"string too long", &CustomField{ID: 1, DataType: CustomFieldTypeString}, NewCustomFieldString(1, string(make([]byte, 129))), true},
+		{"long text", &CustomField{ID: 1, DataType: CustomFieldTypeLongText}, NewCustomFieldLongText(1, string(make([]byte, 1000))), false},
+		{"url", &CustomField{ID: 1, DataType: CustomFieldTypeURL}, NewCustomFieldURL(1, "https://example.com"), false},
+		{"bad url", &CustomField{ID: 1, DataType: CustomFieldTypeURL}, NewCustomFieldURL(1, "example"), true},
+		{"date", &CustomField{ID: 1, DataType: CustomFieldTypeDate}, NewCustomFieldDate(1, time.Now()), false},
+		{"bad date", &CustomField{ID: 1, DataType: CustomFieldTypeDate}, NewCustomFieldString(1, "2024-13-01"), true},
+		{"boolean", &CustomField{ID: 1, DataType: CustomFieldTypeBoolean}, NewCustomFieldBoolean(1, false), false},
+		{"boolean from string", &CustomField{ID: 1, DataType: CustomFieldTypeBoolean}, NewCustomFieldString(1, "true"), true},
+		{"integer", &CustomField{ID: 1, DataType: CustomFieldTypeInteger}, NewCustomFieldInteger(1, 100), false},
+		{"integer out of range", &CustomField{ID: 1, DataType: CustomFieldTypeInteger}, NewCustomFieldInteger(1, 1<<40), true},
+		{"float", &CustomField{ID: 1, DataType: CustomFieldTypeFloat}, NewCustomFieldFloat(1, 0.5), false},
+		{"monetary", &CustomField{ID: 1, DataType: CustomFieldTypeMonetary}, NewCustomFieldMonetary(1, "USD", 1), false},
+		{"bad monetary", &CustomField{ID: 1, DataType: CustomFieldTypeMonetary}, NewCustomFieldString(1, "usd1"), true},
+		{"document link", &CustomField{ID: 1, DataType: CustomFieldTypeDocumentLink}, NewCustomFieldDocumentLink(1, []int64{1}), false},
+		{"bad document link", &CustomField{ID: 1, DataType: CustomFieldTypeDocumentLink}, NewCustomFieldInteger(1, 1), true},
+		{"select", selectField, NewCustomFieldSelect(1, "opt2"), false},
+		{"unknown select option", selectField, NewCustomFieldSelect(1, "opt3"), true},
+		{"legacy select", legacySelectField, CustomFieldInstance{Field: 1, Value: 1}, false},
+		{"legacy select out of range", legacySelectField, CustomFieldInstance{Field: 1, Value: 2}, true},
+		{"unknown type", &CustomField{ID: 1, DataType: "other"}, NewCustomFieldString(1, ""), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := roundTripCustomField(t, tc.value).Validate(tc.field)
+
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Validate() error = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomFieldSelectOptionUnmarshal(t *testing.T) {
+	var got CustomFieldExtraData
+
+	if err := json.Unmarshal([]byte(`{
+		"select_options": ["Legacy", {"id": "x1", "label": "Current"}],
+		"default_currency": "CHF"
+	}`), &got); err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+
+	want := CustomFieldExtraData{
+		SelectOptions: []CustomFieldSelectOption{
+			{Label: "Legacy"},
+			{ID: "x1", Label: "Current"},
+		},
+		DefaultCurrency: String("CHF"),
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unmarshal() diff (-want +got):\n%s", diff)
+	}
+}
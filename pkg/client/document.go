@@ -2,12 +2,18 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/google/go-querystring/query"
+	"github.com/hansmi/paperhooks/internal/httptransport"
 )
 
 type DocumentVersionMetadata struct {
@@ -51,9 +57,12 @@ type ListDocumentsOptions struct {
 	ListOptions
 
 	Ordering            OrderingSpec         `url:"ordering"`
+	ID                  IDFilterSpec         `url:"id"`
 	Owner               IntFilterSpec        `url:"owner"`
 	Title               CharFilterSpec       `url:"title"`
 	Content             CharFilterSpec       `url:"content"`
+	OriginalFilename    CharFilterSpec       `url:"original_filename"`
+	Checksum            CharFilterSpec       `url:"checksum"`
 	ArchiveSerialNumber IntFilterSpec        `url:"archive_serial_number"`
 	Created             DateTimeFilterSpec   `url:"created"`
 	Added               DateTimeFilterSpec   `url:"added"`
@@ -62,35 +71,98 @@ type ListDocumentsOptions struct {
 	Tags                ForeignKeyFilterSpec `url:"tags"`
 	DocumentType        ForeignKeyFilterSpec `url:"document_type"`
 	StoragePath         ForeignKeyFilterSpec `url:"storage_path"`
+	CustomFields        ForeignKeyFilterSpec `url:"custom_fields"`
+
+	// Match documents with or without any tags.
+	IsTagged *bool `url:"is_tagged,omitempty"`
+
+	// Match documents with or without an inbox tag.
+	IsInInbox *bool `url:"is_in_inbox,omitempty"`
+
+	// Match documents with or without any custom fields.
+	HasCustomFields *bool `url:"has_custom_fields,omitempty"`
+
+	// Case-insensitive substring match on title or content.
+	TitleContent *string `url:"title_content,omitempty"`
+
+	// Exact MIME type of the original file, e.g. "application/pdf".
+	MimeType *string `url:"mime_type,omitempty"`
+
+	// Full-text search query. Results are ordered by relevance unless an
+	// ordering is given explicitly.
+	Query string `url:"query,omitempty"`
+
+	// Find documents similar to the document with the given ID.
+	MoreLikeID *int64 `url:"more_like_id,omitempty"`
+
+	// Filter by custom field values.
+	CustomFieldQuery CustomFieldQuery `url:"custom_field_query"`
+}
+
+// SearchHit contains details on a document matched by a full-text search.
+type SearchHit struct {
+	// Relevance score of the match.
+	Score float64 `json:"score"`
+
+	// HTML snippets of the document content matching the query.
+	Highlights string `json:"highlights"`
+
+	// HTML snippets of document notes matching the query.
+	NoteHighlights string `json:"note_highlights"`
+
+	// Position of the document in the result set, starting at zero.
+	Rank int64 `json:"rank"`
 }
 
 func (c *Client) ListDocuments(ctx context.Context, opts ListDocumentsOptions) ([]Document, *Response, error) {
+	ctx = withOperation(ctx, "ListDocuments")
+
 	return crudList[Document](ctx, c.documentCrudOpts(), opts)
 }
 
 // ListAllDocuments iterates over all documents matching the filters specified
 // in opts, invoking handler for each.
 func (c *Client) ListAllDocuments(ctx context.Context, opts ListDocumentsOptions, handler func(context.Context, Document) error) error {
+	ctx = withOperation(ctx, "ListAllDocuments")
+
 	return crudListAll[Document](ctx, c.documentCrudOpts(), opts, handler)
 }
 
+// IterAllDocuments returns an iterator over all documents matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllDocuments(ctx context.Context, opts ListDocumentsOptions) iter.Seq2[Document, error] {
+	ctx = withOperation(ctx, "IterAllDocuments")
+
+	return crudIterAll[Document](ctx, c.documentCrudOpts(), opts)
+}
+
 func (c *Client) GetDocument(ctx context.Context, id int64) (*Document, *Response, error) {
+	ctx = withOperation(ctx, "GetDocument")
+
 	return crudGet[Document](ctx, c.documentCrudOpts(), id)
 }
 
 func (c *Client) UpdateDocument(ctx context.Context, id int64, data *Document) (*Document, *Response, error) {
+	ctx = withOperation(ctx, "UpdateDocument")
+
 	return crudUpdate[Document](ctx, c.documentCrudOpts(), id, data)
 }
 
 func (c *Client) PatchDocument(ctx context.Context, id int64, data *DocumentFields) (*Document, *Response, error) {
+	ctx = withOperation(ctx, "PatchDocument")
+
 	return crudPatch[Document](ctx, c.documentCrudOpts(), id, data)
 }
 
 func (c *Client) DeleteDocument(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteDocument")
+
 	return crudDelete[Document](ctx, c.documentCrudOpts(), id)
 }
 
 func (c *Client) GetDocumentMetadata(ctx context.Context, id int64) (*DocumentMetadata, *Response, error) {
+	ctx = withOperation(ctx, "GetDocumentMetadata")
+
 	resp, err := c.newRequest(ctx).
 		SetResult(DocumentMetadata{}).
 		Get(fmt.Sprintf("api/documents/%d/metadata/", id))
@@ -125,6 +197,15 @@ type DocumentUploadOptions struct {
 
 	// Archive serial number to set on the document.
 	ArchiveSerialNumber *int64 `url:"archive_serial_number,omitempty"`
+
+	// Custom field values for the document. Use [CustomFieldInstance.Validate]
+	// to check values before uploading.
+	CustomFields []CustomFieldInstance `url:"-"`
+
+	// Uploads are not retried by default as a request failing after the
+	// server received the file could lead to duplicate documents. Set to true
+	// to apply the client's [RetryPolicy] nonetheless.
+	AllowRetry bool `url:"-"`
 }
 
 type DocumentUpload struct {
@@ -136,8 +217,14 @@ type DocumentUpload struct {
 // information about the consumption process is available immediately. Poll the
 // returned task ID to wait for the consumption.
 func (c *Client) UploadDocument(ctx context.Context, r io.Reader, opts DocumentUploadOptions) (*DocumentUpload, *Response, error) {
+	ctx = withOperation(ctx, "UploadDocument")
+
 	result := &DocumentUpload{}
 
+	if opts.AllowRetry {
+		ctx = httptransport.WithRetryAllowed(ctx)
+	}
+
 	req := c.newRequest(ctx).
 		SetResult(&result.TaskID).
 		SetFileReader("document", filepath.Base(opts.Filename), r)
@@ -148,6 +235,24 @@ func (c *Client) UploadDocument(ctx context.Context, r io.Reader, opts DocumentU
 		req.SetFormDataFromValues(values)
 	}
 
+	if len(opts.CustomFields) > 0 {
+		// Field IDs mapped to values.
+		fields := map[string]any{}
+
+		for _, i := range opts.CustomFields {
+			fields[strconv.FormatInt(i.Field, 10)] = i.Value
+		}
+
+		encoded, err := json.Marshal(fields)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req.SetFormData(map[string]string{
+			"custom_fields": string(encoded),
+		})
+	}
+
 	resp, err := req.Post("api/documents/post_document/")
 
 	if err := convertError(err, resp); err != nil {
@@ -156,3 +261,87 @@ func (c *Client) UploadDocument(ctx context.Context, r io.Reader, opts DocumentU
 
 	return result, wrapResponse(resp), nil
 }
+
+var duplicateDocumentRe = regexp.MustCompile(`(?i)\bit is a duplicate\b(?:.*?\(#(\d+)\))?`)
+
+// DuplicateDocumentError is returned by [Client.UploadDocumentAndWait] when
+// the uploaded file was rejected as a duplicate of an existing document.
+type DuplicateDocumentError struct {
+	TaskID string
+
+	// ID of the existing document. Zero if not reported by the server.
+	DocumentID int64
+
+	// Message reported by the server.
+	Message string
+}
+
+func (e *DuplicateDocumentError) Error() string {
+	if e.DocumentID == 0 {
+		return fmt.Sprintf("task %q: document is a duplicate", e.TaskID)
+	}
+
+	return fmt.Sprintf("task %q: document is a duplicate of document %d", e.TaskID, e.DocumentID)
+}
+
+func (e *DuplicateDocumentError) Is(other error) bool {
+	err, ok := other.(*DuplicateDocumentError)
+
+	return ok && e.TaskID == err.TaskID && e.DocumentID == err.DocumentID
+}
+
+func duplicateDocumentErrorFromTask(err error) error {
+	var taskErr *TaskError
+
+	if !(errors.As(err, &taskErr) && taskErr.Status == TaskFailure) {
+		return err
+	}
+
+	m := duplicateDocumentRe.FindStringSubmatch(taskErr.Message)
+	if m == nil {
+		return err
+	}
+
+	result := &DuplicateDocumentError{
+		TaskID:  taskErr.TaskID,
+		Message: taskErr.Message,
+	}
+
+	if m[1] != "" {
+		result.DocumentID, _ = strconv.ParseInt(m[1], 10, 64)
+	}
+
+	return result
+}
+
+// UploadDocumentAndWait uploads a file, waits for its consumption to finish
+// and retrieves the resulting document. Files rejected as a duplicate are
+// reported as an error of type [DuplicateDocumentError]. Other consumption
+// failures are reported as [TaskError].
+func (c *Client) UploadDocumentAndWait(ctx context.Context, r io.Reader, opts DocumentUploadOptions, waitOpts WaitForTaskOptions) (*Document, *Response, error) {
+	ctx = withOperation(ctx, "UploadDocumentAndWait")
+
+	upload, resp, err := c.UploadDocument(ctx, r, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	task, err := c.WaitForTask(ctx, upload.TaskID, waitOpts)
+	if err != nil {
+		return nil, nil, duplicateDocumentErrorFromTask(err)
+	}
+
+	id := task.RelatedDocument
+
+	if id == nil && task.Result != nil {
+		if ids := documentIDsFromTaskResult(*task.Result); len(ids) > 0 {
+			id = &ids[0]
+		}
+	}
+
+	if id == nil {
+		return nil, nil, fmt.Errorf("task %q didn't report a document ID", task.TaskID)
+	}
+
+	return c.GetDocument(ctx, *id)
+}
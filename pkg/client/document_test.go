@@ -114,6 +114,24 @@ func TestUploadDocument(t *testing.T) {
 				TaskID: "0dbf0a2b-3a09-4d7b-96bf-51544dda8427",
 			},
 		},
+		{
+			name: "custom fields",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterMatcherResponder(http.MethodPost, "/api/documents/post_document/",
+					httpmock.BodyContainsString(`{"3":"EUR12.50","4":true}`),
+					httpmock.NewStringResponder(http.StatusOK, `"3bd4ed4b-0b8d-4b9a-a2de-3c0f6f3e8c11"`))
+			},
+			r: strings.NewReader("content"),
+			opts: DocumentUploadOptions{
+				CustomFields: []CustomFieldInstance{
+					NewCustomFieldMonetary(3, "EUR", 12.5),
+					NewCustomFieldBoolean(4, true),
+				},
+			},
+			want: &DocumentUpload{
+				TaskID: "3bd4ed4b-0b8d-4b9a-a2de-3c0f6f3e8c11",
+			},
+		},
 		{
 			name: "error",
 			setup: func(t *testing.T, transport *httpmock.MockTransport) {
@@ -150,3 +168,248 @@ func TestUploadDocument(t *testing.T) {
 		})
 	}
 }
+
+func TestListAllDocumentsSearch(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+		"page=1&page_size=25&query=invoice+2024",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"next": "?page=2",
+			"results": [
+				{
+					"id": 8,
+					"title": "Invoice March",
+					"__search_hit__": {
+						"score": 4.5,
+						"highlights": "<span class=\"match\">invoice</span> for March",
+						"note_highlights": "",
+						"rank": 0
+					}
+				},
+				{
+					"id": 3,
+					"title": "Invoice January",
+					"__search_hit__": { "score": 2.25, "rank": 1 }
+				}
+			]
+		}`))
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+		"page=2&page_size=25&query=invoice+2024",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"results": [
+				{
+					"id": 3,
+					"title": "Invoice January",
+					"__search_hit__": { "score": 2.25, "rank": 2 }
+				},
+				{
+					"id": 5,
+					"title": "Receipt",
+					"__search_hit__": { "score": 1, "note_highlights": "paid <span class=\"match\">invoice</span>", "rank": 3 }
+				}
+			]
+		}`))
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/documents/",
+		"page=1&page_size=25&more_like_id=8",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"results": [
+				{ "id": 3, "__search_hit__": { "score": 0.5, "rank": 0 } }
+			]
+		}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	for _, tc := range []struct {
+		name string
+		opts ListDocumentsOptions
+		want []Document
+	}{
+		{
+			name: "query",
+			opts: ListDocumentsOptions{
+				Query: "invoice 2024",
+			},
+			want: []Document{
+				{
+					ID:    8,
+					Title: "Invoice March",
+					SearchHit: &SearchHit{
+						Score:      4.5,
+						Highlights: `<span class="match">invoice</span> for March`,
+					},
+				},
+				{
+					ID:        3,
+					Title:     "Invoice January",
+					SearchHit: &SearchHit{Score: 2.25, Rank: 1},
+				},
+				{
+					ID:    5,
+					Title: "Receipt",
+					SearchHit: &SearchHit{
+						Score:          1,
+						NoteHighlights: `paid <span class="match">invoice</span>`,
+						Rank:           3,
+					},
+				},
+			},
+		},
+		{
+			name: "more like",
+			opts: ListDocumentsOptions{
+				MoreLikeID: Int64(8),
+			},
+			want: []Document{
+				{ID: 3, SearchHit: &SearchHit{Score: 0.5}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []Document
+
+			if err := c.ListAllDocuments(context.Background(), tc.opts, func(_ context.Context, d Document) error {
+				got = append(got, d)
+				return nil
+			}); err != nil {
+				t.Fatalf("ListAllDocuments() failed: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("ListAllDocuments() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUploadDocumentAndWait(t *testing.T) {
+	const taskID = "4e9a7a0a-5b0c-4bb6-9d55-6a1f3e7b7e23"
+
+	setupUpload := func(transport *httpmock.MockTransport, taskJSON string) {
+		transport.RegisterResponder(http.MethodPost, "/api/documents/post_document/",
+			httpmock.NewStringResponder(http.StatusOK, `"`+taskID+`"`))
+		transport.RegisterResponderWithQuery(http.MethodGet, "/api/tasks/", "task_id="+taskID,
+			httpmock.NewStringResponder(http.StatusOK, `[`+taskJSON+`]`))
+	}
+
+	for _, tc := range []struct {
+		name    string
+		setup   func(*testing.T, *httpmock.MockTransport)
+		want    *Document
+		wantErr error
+	}{
+		{
+			name: "related document",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				setupUpload(transport, `{
+					"task_id": "`+taskID+`",
+					"status": "SUCCESS",
+					"result": "Success. New document id 310 created",
+					"related_document": "310"
+				}`)
+				transport.RegisterResponder(http.MethodGet, "/api/documents/310/",
+					httpmock.NewStringResponder(http.StatusOK, `{"id": 310, "title": "Scan"}`))
+			},
+			want: &Document{ID: 310, Title: "Scan"},
+		},
+		{
+			name: "result text",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				setupUpload(transport, `{
+					"task_id": "`+taskID+`",
+					"status": "SUCCESS",
+					"result": "Success. New document id 311 created"
+				}`)
+				transport.RegisterResponder(http.MethodGet, "/api/documents/311/",
+					httpmock.NewStringResponder(http.StatusOK, `{"id": 311}`))
+			},
+			want: &Document{ID: 311},
+		},
+		{
+			name: "duplicate",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				setupUpload(transport, `{
+					"task_id": "`+taskID+`",
+					"status": "FAILURE",
+					"result": "scan.pdf: Not consuming scan.pdf: It is a duplicate of Invoice (#123)."
+				}`)
+			},
+			wantErr: &DuplicateDocumentError{
+				TaskID:     taskID,
+				DocumentID: 123,
+			},
+		},
+		{
+			name: "duplicate without ID",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				setupUpload(transport, `{
+					"task_id": "`+taskID+`",
+					"status": "FAILURE",
+					"result": "scan.pdf: Not consuming scan.pdf: It is a duplicate."
+				}`)
+			},
+			wantErr: &DuplicateDocumentError{
+				TaskID: taskID,
+			},
+		},
+		{
+			name: "failure",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				setupUpload(transport, `{
+					"task_id": "`+taskID+`",
+					"status": "FAILURE",
+					"result": "scan.pdf: Error occurred while consuming document"
+				}`)
+			},
+			wantErr: &TaskError{
+				TaskID: taskID,
+				Status: TaskFailure,
+			},
+		},
+		{
+			name: "no document ID",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				setupUpload(transport, `{
+					"task_id": "`+taskID+`",
+					"status": "SUCCESS",
+					"result": "Done"
+				}`)
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "upload fails",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/post_document/",
+					httpmock.NewStringResponder(http.StatusForbidden, `{}`))
+			},
+			wantErr: &RequestError{
+				StatusCode: http.StatusForbidden,
+				Message:    `{}`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			tc.setup(t, transport)
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			got, _, err := c.UploadDocumentAndWait(context.Background(), strings.NewReader("content"), DocumentUploadOptions{}, WaitForTaskOptions{})
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("UploadDocumentAndWait() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("UploadDocumentAndWait() result diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,421 @@
+package client
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// PageRange is an inclusive range of 1-based page numbers.
+type PageRange struct {
+	First int
+	Last  int
+}
+
+func (r PageRange) validate() error {
+	if r.First < 1 {
+		return fmt.Errorf("invalid page number %d", r.First)
+	}
+
+	if r.Last < r.First {
+		return fmt.Errorf("invalid page range %d-%d", r.First, r.Last)
+	}
+
+	return nil
+}
+
+func (r PageRange) String() string {
+	if r.First == r.Last {
+		return strconv.Itoa(r.First)
+	}
+
+	return fmt.Sprintf("%d-%d", r.First, r.Last)
+}
+
+// PageRanges is a list of page ranges, e.g. "1,3-5".
+type PageRanges []PageRange
+
+// ParsePageRanges parses a comma-separated list of page numbers and inclusive
+// page ranges, e.g. "1,3-5".
+func ParsePageRanges(s string) (PageRanges, error) {
+	var result PageRanges
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+
+		var r PageRange
+		var err error
+
+		if first, last, ok := strings.Cut(part, "-"); ok {
+			if r.First, err = strconv.Atoi(strings.TrimSpace(first)); err == nil {
+				r.Last, err = strconv.Atoi(strings.TrimSpace(last))
+			}
+		} else if r.First, err = strconv.Atoi(part); err == nil {
+			r.Last = r.First
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing page range %q: %w", part, err)
+		}
+
+		result = append(result, r)
+	}
+
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Validate returns an error if the list is empty or contains invalid page
+// ranges.
+func (r PageRanges) Validate() error {
+	if len(r) == 0 {
+		return errors.New("no pages specified")
+	}
+
+	for _, i := range r {
+		if err := i.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r PageRanges) String() string {
+	parts := make([]string, 0, len(r))
+
+	for _, i := range r {
+		parts = append(parts, i.String())
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// BulkEditMerge merges all documents into a new document in the order in which
+// they're given.
+type BulkEditMerge struct {
+	// Copy metadata such as tags and correspondent from the given document.
+	MetadataDocumentID *int64
+
+	// Delete the original documents after a successful merge.
+	DeleteOriginals bool
+
+	// Use the original file if a document has no archived version.
+	ArchiveFallback bool
+}
+
+var _ BulkEditOperation = (*BulkEditMerge)(nil)
+
+func (o BulkEditMerge) bulkEditMethod() (string, map[string]any, error) {
+	params := map[string]any{
+		"delete_originals": o.DeleteOriginals,
+		"archive_fallback": o.ArchiveFallback,
+	}
+
+	if o.MetadataDocumentID != nil {
+		params["metadata_document_id"] = *o.MetadataDocumentID
+	}
+
+	return "merge", params, nil
+}
+
+// BulkEditSplit splits a single document into multiple new documents, one for
+// each page range.
+type BulkEditSplit struct {
+	Pages PageRanges
+
+	// Delete the original document after a successful split.
+	DeleteOriginals bool
+}
+
+var _ BulkEditOperation = (*BulkEditSplit)(nil)
+
+func (o BulkEditSplit) bulkEditMethod() (string, map[string]any, error) {
+	const method = "split"
+
+	if err := o.Pages.Validate(); err != nil {
+		return method, nil, err
+	}
+
+	return method, map[string]any{
+		"pages":            o.Pages.String(),
+		"delete_originals": o.DeleteOriginals,
+	}, nil
+}
+
+// BulkEditRotate rotates all pages of all documents clockwise.
+type BulkEditRotate struct {
+	// Rotation in degrees; must be a multiple of 90.
+	Degrees int
+}
+
+var _ BulkEditOperation = (*BulkEditRotate)(nil)
+
+func (o BulkEditRotate) bulkEditMethod() (string, map[string]any, error) {
+	const method = "rotate"
+
+	if o.Degrees%90 != 0 {
+		return method, nil, fmt.Errorf("rotation of %d degrees is not a multiple of 90", o.Degrees)
+	}
+
+	return method, map[string]any{"degrees": o.Degrees}, nil
+}
+
+// BulkEditDeletePages removes pages from a single document.
+type BulkEditDeletePages struct {
+	Pages PageRanges
+}
+
+var _ BulkEditOperation = (*BulkEditDeletePages)(nil)
+
+func (o BulkEditDeletePages) bulkEditMethod() (string, map[string]any, error) {
+	const method = "delete_pages"
+
+	if err := o.Pages.Validate(); err != nil {
+		return method, nil, err
+	}
+
+	pages := []int{}
+
+	for _, r := range o.Pages {
+		for page := r.First; page <= r.Last; page++ {
+			pages = append(pages, page)
+		}
+	}
+
+	return method, map[string]any{"pages": pages}, nil
+}
+
+// EditPDFOperation describes a page in the output of [BulkEditEditPDF].
+type EditPDFOperation struct {
+	// 1-based page number in the source document.
+	Page int `json:"page"`
+
+	// Clockwise rotation in degrees; must be a multiple of 90.
+	Rotate int `json:"rotate,omitempty"`
+
+	// Zero-based index of the output document. Pages with different indices
+	// are written to separate documents.
+	Doc int `json:"doc,omitempty"`
+}
+
+// BulkEditEditPDF rearranges, rotates and splits the pages of a single
+// document.
+type BulkEditEditPDF struct {
+	Operations []EditPDFOperation
+
+	// Delete the original document after a successful edit.
+	DeleteOriginal bool
+
+	// Replace the content of the original document instead of creating new
+	// documents. Only permitted with a single output document.
+	UpdateDocument bool
+
+	// Don't copy metadata (tags, correspondent, etc.) from the original
+	// document to the new documents.
+	DropMetadata bool
+}
+
+var _ BulkEditOperation = (*BulkEditEditPDF)(nil)
+
+func (o BulkEditEditPDF) bulkEditMethod() (string, map[string]any, error) {
+	const method = "edit_pdf"
+
+	if len(o.Operations) == 0 {
+		return method, nil, errors.New("no operations specified")
+	}
+
+	docs := map[int]struct{}{}
+
+	for _, op := range o.Operations {
+		if op.Page < 1 {
+			return method, nil, fmt.Errorf("invalid page number %d", op.Page)
+		}
+
+		if op.Rotate%90 != 0 {
+			return method, nil, fmt.Errorf("rotation of %d degrees is not a multiple of 90", op.Rotate)
+		}
+
+		if op.Doc < 0 {
+			return method, nil, fmt.Errorf("invalid output document index %d", op.Doc)
+		}
+
+		docs[op.Doc] = struct{}{}
+	}
+
+	if o.UpdateDocument && len(docs) > 1 {
+		return method, nil, errors.New("updating the document requires a single output document")
+	}
+
+	return method, map[string]any{
+		"operations":       o.Operations,
+		"delete_original":  o.DeleteOriginal,
+		"update_document":  o.UpdateDocument,
+		"include_metadata": !o.DropMetadata,
+	}, nil
+}
+
+// MergeDocuments merges multiple documents into a new document. See
+// [BulkEditMerge] for details.
+func (c *Client) MergeDocuments(ctx context.Context, documents []int64, opts BulkEditMerge) (*BulkEditResult, *Response, error) {
+	ctx = withOperation(ctx, "MergeDocuments")
+
+	return c.BulkEditDocuments(ctx, documents, opts)
+}
+
+// SplitDocument splits a document into multiple new documents, one for each
+// page range.
+func (c *Client) SplitDocument(ctx context.Context, id int64, opts BulkEditSplit) (*BulkEditResult, *Response, error) {
+	ctx = withOperation(ctx, "SplitDocument")
+
+	return c.BulkEditDocuments(ctx, []int64{id}, opts)
+}
+
+// RotateDocuments rotates all pages of the given documents clockwise.
+func (c *Client) RotateDocuments(ctx context.Context, documents []int64, degrees int) (*BulkEditResult, *Response, error) {
+	ctx = withOperation(ctx, "RotateDocuments")
+
+	return c.BulkEditDocuments(ctx, documents, BulkEditRotate{Degrees: degrees})
+}
+
+// DeleteDocumentPages removes pages from a document.
+func (c *Client) DeleteDocumentPages(ctx context.Context, id int64, pages PageRanges) (*BulkEditResult, *Response, error) {
+	ctx = withOperation(ctx, "DeleteDocumentPages")
+
+	return c.BulkEditDocuments(ctx, []int64{id}, BulkEditDeletePages{Pages: pages})
+}
+
+// EditDocumentPDF rearranges, rotates and splits the pages of a document. See
+// [BulkEditEditPDF] for details.
+func (c *Client) EditDocumentPDF(ctx context.Context, id int64, opts BulkEditEditPDF) (*BulkEditResult, *Response, error) {
+	ctx = withOperation(ctx, "EditDocumentPDF")
+
+	return c.BulkEditDocuments(ctx, []int64{id}, opts)
+}
+
+// Regular expression matching document IDs in task results. Example:
+// "Success. New document id 26150 created".
+var taskResultDocumentIDRe = regexp.MustCompile(`(?i)\bdocument id (\d+)\b`)
+
+func documentIDsFromTaskResult(result string) []int64 {
+	var ids []int64
+
+	for _, m := range taskResultDocumentIDRe.FindAllStringSubmatch(result, -1) {
+		if id, err := strconv.ParseInt(m[1], 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// WaitForCreatedDocumentsOptions configures [Client.WaitForCreatedDocuments].
+type WaitForCreatedDocumentsOptions struct {
+	WaitForTaskOptions
+
+	// Only consider consumption tasks created after the given time. Should be
+	// taken immediately before starting the operation and must be comparable
+	// with the clock of the server. Required.
+	Since time.Time
+
+	// Number of documents the operation creates, e.g. the number of page
+	// ranges for [BulkEditSplit] or 1 for [BulkEditMerge].
+	Count int
+}
+
+// WaitForCreatedDocuments waits for the consumption tasks started by
+// a page-level operation (e.g. [Client.SplitDocument]) and returns the IDs of
+// the created documents. The server doesn't report the tasks started by such
+// operations. Instead the consumption tasks created after the start time are
+// observed until the expected number of them has finished. An error is
+// returned if more tasks than expected are found, e.g. due to concurrent
+// uploads, as they can't be attributed reliably.
+func (c *Client) WaitForCreatedDocuments(ctx context.Context, opts WaitForCreatedDocumentsOptions) ([]int64, []Task, error) {
+	ctx = withOperation(ctx, "WaitForCreatedDocuments")
+
+	if opts.Count < 1 {
+		return nil, nil, fmt.Errorf("invalid number of expected documents: %d", opts.Count)
+	}
+
+	if opts.Since.IsZero() {
+		return nil, nil, errors.New("start time of the operation is required")
+	}
+
+	listOpts := ListTasksOptions{
+		TaskName:     "consume_file",
+		CreatedAfter: &opts.Since,
+	}
+
+	tasks, err := backoff.RetryNotifyWithData(func() ([]Task, error) {
+		tasks, _, err := c.ListTasksWithOptions(ctx, listOpts)
+
+		if err != nil {
+			var reqErr *RequestError
+
+			if errors.As(err, &reqErr) && (reqErr.StatusCode/100) == 5 {
+				return nil, err
+			}
+
+			return nil, backoff.Permanent(err)
+		}
+
+		if len(tasks) > opts.Count {
+			return nil, backoff.Permanent(fmt.Errorf("found %d consumption tasks, expected %d", len(tasks), opts.Count))
+		}
+
+		if len(tasks) < opts.Count {
+			return nil, fmt.Errorf("found %d of %d consumption tasks", len(tasks), opts.Count)
+		}
+
+		for _, task := range tasks {
+			if opts.Condition != nil {
+				if err := opts.Condition(&task); err != nil {
+					return nil, err
+				}
+			} else if err := DefaultWaitForTaskCondition(&task); err != nil {
+				return nil, err
+			}
+		}
+
+		return tasks, nil
+	}, backoff.WithContext(opts.makeBackOff(), ctx), func(err error, delay time.Duration) {
+		logAttrs(c.logger, slog.LevelDebug, "Consumption tasks not finished, retrying",
+			slog.Duration("delay", delay),
+			slog.Any("error", err))
+	})
+	if err != nil {
+		return nil, tasks, err
+	}
+
+	slices.SortFunc(tasks, func(a, b Task) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	var ids []int64
+
+	for _, task := range tasks {
+		if err := task.statusError(); err != nil {
+			return nil, tasks, err
+		}
+
+		if task.RelatedDocument != nil {
+			ids = append(ids, *task.RelatedDocument)
+		} else if task.Result != nil {
+			ids = append(ids, documentIDsFromTaskResult(*task.Result)...)
+		}
+	}
+
+	return ids, tasks, nil
+}
@@ -0,0 +1,320 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestParsePageRanges(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		want    PageRanges
+		wantErr error
+	}{
+		{input: "", wantErr: cmpopts.AnyError},
+		{input: "0", wantErr: cmpopts.AnyError},
+		{input: "-1", wantErr: cmpopts.AnyError},
+		{input: "5-3", wantErr: cmpopts.AnyError},
+		{input: "1,,2", wantErr: cmpopts.AnyError},
+		{input: "a-b", wantErr: cmpopts.AnyError},
+		{
+			input: "1",
+			want:  PageRanges{{1, 1}},
+		},
+		{
+			input: "1,3-5",
+			want:  PageRanges{{1, 1}, {3, 5}},
+		},
+		{
+			input: " 2 - 4 , 7 ",
+			want:  PageRanges{{2, 4}, {7, 7}},
+		},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParsePageRanges(tc.input)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("ParsePageRanges() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("ParsePageRanges() diff (-want +got):\n%s", diff)
+				}
+
+				if roundtrip, err := ParsePageRanges(got.String()); err != nil {
+					t.Errorf("ParsePageRanges(%q) failed: %v", got.String(), err)
+				} else if diff := cmp.Diff(got, roundtrip); diff != "" {
+					t.Errorf("Roundtrip diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPageOperations(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		call     func(*Client) (*BulkEditResult, *Response, error)
+		wantBody map[string]any
+		wantErr  error
+	}{
+		{
+			name: "merge",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.MergeDocuments(context.Background(), []int64{3, 1, 2}, BulkEditMerge{
+					MetadataDocumentID: Int64(1),
+					DeleteOriginals:    true,
+				})
+			},
+			wantBody: map[string]any{
+				"documents": []int64{3, 1, 2},
+				"method":    "merge",
+				"parameters": map[string]any{
+					"metadata_document_id": 1,
+					"delete_originals":     true,
+					"archive_fallback":     false,
+				},
+			},
+		},
+		{
+			name: "split",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.SplitDocument(context.Background(), 17, BulkEditSplit{
+					Pages: PageRanges{{1, 1}, {3, 5}},
+				})
+			},
+			wantBody: map[string]any{
+				"documents": []int64{17},
+				"method":    "split",
+				"parameters": map[string]any{
+					"pages":            "1,3-5",
+					"delete_originals": false,
+				},
+			},
+		},
+		{
+			name: "split without pages",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.SplitDocument(context.Background(), 17, BulkEditSplit{})
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "rotate",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.RotateDocuments(context.Background(), []int64{4, 5}, 270)
+			},
+			wantBody: map[string]any{
+				"documents":  []int64{4, 5},
+				"method":     "rotate",
+				"parameters": map[string]any{"degrees": 270},
+			},
+		},
+		{
+			name: "rotate invalid",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.RotateDocuments(context.Background(), []int64{4}, 45)
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "delete pages",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.DeleteDocumentPages(context.Background(), 8, PageRanges{{2, 4}, {9, 9}})
+			},
+			wantBody: map[string]any{
+				"documents":  []int64{8},
+				"method":     "delete_pages",
+				"parameters": map[string]any{"pages": []int{2, 3, 4, 9}},
+			},
+		},
+		{
+			name: "edit pdf",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.EditDocumentPDF(context.Background(), 9, BulkEditEditPDF{
+					Operations: []EditPDFOperation{
+						{Page: 2, Rotate: 90},
+						{Page: 1, Doc: 1},
+					},
+				})
+			},
+			wantBody: map[string]any{
+				"documents": []int64{9},
+				"method":    "edit_pdf",
+				"parameters": map[string]any{
+					"operations": []map[string]any{
+						{"page": 2, "rotate": 90},
+						{"page": 1, "doc": 1},
+					},
+					"delete_original":  false,
+					"update_document":  false,
+					"include_metadata": true,
+				},
+			},
+		},
+		{
+			name: "edit pdf without metadata",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.EditDocumentPDF(context.Background(), 9, BulkEditEditPDF{
+					Operations:     []EditPDFOperation{{Page: 1}},
+					DeleteOriginal: true,
+					DropMetadata:   true,
+				})
+			},
+			wantBody: map[string]any{
+				"documents": []int64{9},
+				"method":    "edit_pdf",
+				"parameters": map[string]any{
+					"operations":       []map[string]any{{"page": 1}},
+					"delete_original":  true,
+					"update_document":  false,
+					"include_metadata": false,
+				},
+			},
+		},
+		{
+			name: "edit pdf update multiple",
+			call: func(c *Client) (*BulkEditResult, *Response, error) {
+				return c.EditDocumentPDF(context.Background(), 9, BulkEditEditPDF{
+					Operations: []EditPDFOperation{
+						{Page: 1},
+						{Page: 2, Doc: 1},
+					},
+					UpdateDocument: true,
+				})
+			},
+			wantErr: cmpopts.AnyError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			if tc.wantBody != nil {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/bulk_edit/",
+					newJSONBodyResponder(t, tc.wantBody,
+						httpmock.NewStringResponder(http.StatusOK, `{"result": "OK"}`)))
+			}
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			got, _, err := tc.call(c)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Operation error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(&BulkEditResult{Result: "OK"}, got); diff != "" {
+					t.Errorf("Operation result diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitForCreatedDocuments(t *testing.T) {
+	since := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name      string
+		zeroSince bool
+		count     int
+		tasks     string
+		want      []int64
+		wantErr   error
+	}{
+		{
+			name:    "no count",
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:      "no start time",
+			zeroSince: true,
+			count:     1,
+			wantErr:   cmpopts.AnyError,
+		},
+		{
+			name:  "split",
+			count: 2,
+			tasks: `[
+				{ "id": 1, "task_id": "old", "date_created": "2024-03-01T11:00:00Z", "status": "SUCCESS", "related_document": "7" },
+				{ "id": 3, "task_id": "second", "date_created": "2024-03-01T12:00:02Z", "status": "SUCCESS", "related_document": "292" },
+				{ "id": 2, "task_id": "first", "date_created": "2024-03-01T12:00:01Z", "status": "SUCCESS", "result": "Success. New document id 291 created" }
+			]`,
+			want: []int64{291, 292},
+		},
+		{
+			name:  "failure",
+			count: 1,
+			tasks: `[
+				{ "id": 4, "task_id": "fail", "date_created": "2024-03-01T12:00:01Z", "status": "FAILURE", "result": "Error" }
+			]`,
+			wantErr: &TaskError{
+				TaskID: "fail",
+				Status: TaskFailure,
+			},
+		},
+		{
+			name:  "ambiguous",
+			count: 1,
+			tasks: `[
+				{ "id": 5, "task_id": "a", "date_created": "2024-03-01T12:00:01Z", "status": "SUCCESS", "related_document": "1" },
+				{ "id": 6, "task_id": "b", "date_created": "2024-03-01T12:00:02Z", "status": "SUCCESS", "related_document": "2" }
+			]`,
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name:  "incomplete",
+			count: 2,
+			tasks: `[
+				{ "id": 7, "task_id": "a", "date_created": "2024-03-01T12:00:01Z", "status": "SUCCESS", "related_document": "1" }
+			]`,
+			wantErr: cmpopts.AnyError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			if tc.tasks != "" {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/tasks/",
+					"task_name=consume_file",
+					httpmock.NewStringResponder(http.StatusOK, tc.tasks))
+			}
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			opts := WaitForCreatedDocumentsOptions{
+				WaitForTaskOptions: WaitForTaskOptions{
+					MaxElapsedTime: time.Second,
+				},
+				Since: since,
+				Count: tc.count,
+			}
+
+			if tc.zeroSince {
+				opts.Since = time.Time{}
+			}
+
+			got, _, err := c.WaitForCreatedDocuments(context.Background(), opts)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("WaitForCreatedDocuments() error diff (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("WaitForCreatedDocuments() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
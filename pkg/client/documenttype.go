@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 )
 
 func (c *Client) documentTypeCrudOpts() crudOptions {
@@ -21,36 +22,59 @@ type ListDocumentTypesOptions struct {
 	ListOptions
 
 	Ordering OrderingSpec   `url:"ordering"`
+	ID       IDFilterSpec   `url:"id"`
 	Owner    IntFilterSpec  `url:"owner"`
 	Name     CharFilterSpec `url:"name"`
 }
 
 func (c *Client) ListDocumentTypes(ctx context.Context, opts ListDocumentTypesOptions) ([]DocumentType, *Response, error) {
+	ctx = withOperation(ctx, "ListDocumentTypes")
+
 	return crudList[DocumentType](ctx, c.documentTypeCrudOpts(), opts)
 }
 
 // ListAllDocumentTypes iterates over all document types matching the filters
 // specified in opts, invoking handler for each.
 func (c *Client) ListAllDocumentTypes(ctx context.Context, opts ListDocumentTypesOptions, handler func(context.Context, DocumentType) error) error {
+	ctx = withOperation(ctx, "ListAllDocumentTypes")
+
 	return crudListAll[DocumentType](ctx, c.documentTypeCrudOpts(), opts, handler)
 }
 
+// IterAllDocumentTypes returns an iterator over all document types matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllDocumentTypes(ctx context.Context, opts ListDocumentTypesOptions) iter.Seq2[DocumentType, error] {
+	ctx = withOperation(ctx, "IterAllDocumentTypes")
+
+	return crudIterAll[DocumentType](ctx, c.documentTypeCrudOpts(), opts)
+}
+
 func (c *Client) GetDocumentType(ctx context.Context, id int64) (*DocumentType, *Response, error) {
+	ctx = withOperation(ctx, "GetDocumentType")
+
 	return crudGet[DocumentType](ctx, c.documentTypeCrudOpts(), id)
 }
 
 func (c *Client) CreateDocumentType(ctx context.Context, data *DocumentTypeFields) (*DocumentType, *Response, error) {
+	ctx = withOperation(ctx, "CreateDocumentType")
+
 	return crudCreate[DocumentType](ctx, c.documentTypeCrudOpts(), data)
 }
 
 func (c *Client) UpdateDocumentType(ctx context.Context, id int64, data *DocumentType) (*DocumentType, *Response, error) {
+	ctx = withOperation(ctx, "UpdateDocumentType")
+
 	return crudUpdate[DocumentType](ctx, c.documentTypeCrudOpts(), id, data)
 }
 
 func (c *Client) PatchDocumentType(ctx context.Context, id int64, data *DocumentTypeFields) (*DocumentType, *Response, error) {
+	ctx = withOperation(ctx, "PatchDocumentType")
+
 	return crudPatch[DocumentType](ctx, c.documentTypeCrudOpts(), id, data)
 }
 
 func (c *Client) DeleteDocumentType(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteDocumentType")
+
 	return crudDelete[DocumentType](ctx, c.documentTypeCrudOpts(), id)
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime"
 	"path/filepath"
 
+	"github.com/go-resty/resty/v2"
 	"go.uber.org/multierr"
 )
 
@@ -24,11 +26,16 @@ type DownloadResult struct {
 	Length int64
 }
 
-func (c *Client) download(ctx context.Context, w io.Writer, url string, expectDisposition bool) (_ *DownloadResult, _ *Response, err error) {
-	req := c.newRequest(ctx).
-		SetDoNotParseResponse(true)
+func (c *Client) download(ctx context.Context, w io.Writer, url string, expectDisposition bool) (*DownloadResult, *Response, error) {
+	return c.downloadRequest(w, c.newRequest(ctx), resty.MethodGet, url, expectDisposition)
+}
 
-	resp, err := req.Get(url)
+// downloadRequest executes a prepared request and writes the response body to
+// the given writer.
+func (c *Client) downloadRequest(w io.Writer, req *resty.Request, method, url string, expectDisposition bool) (_ *DownloadResult, _ *Response, err error) {
+	resp, err := req.
+		SetDoNotParseResponse(true).
+		Execute(method, url)
 
 	if !(resp == nil || resp.RawBody() == nil) {
 		defer multierr.AppendFunc(&err, resp.RawBody().Close)
@@ -46,10 +53,13 @@ func (c *Client) download(ctx context.Context, w io.Writer, url string, expectDi
 
 	if contentDisposition := resp.Header().Get("Content-Disposition"); contentDisposition == "" {
 		if expectDisposition {
-			c.logger.Warnf("%s: missing Content-Disposition header", req.URL)
+			logAttrs(c.logger, slog.LevelWarn, "Missing Content-Disposition header",
+				slog.String("url", req.URL))
 		}
 	} else if _, params, err := mime.ParseMediaType(contentDisposition); err != nil {
-		c.logger.Warnf("%s: parsing content-disposition header failed: %w", req.URL, err)
+		logAttrs(c.logger, slog.LevelWarn, "Parsing Content-Disposition header failed",
+			slog.String("url", req.URL),
+			slog.Any("error", err))
 	} else if filename, ok := params["filename"]; ok && filename != "" {
 		result.Filename = filepath.Base(filepath.Clean(params["filename"]))
 	}
@@ -70,6 +80,8 @@ func (c *Client) download(ctx context.Context, w io.Writer, url string, expectDi
 // the document is complete (the HTTP request may have been terminated early)
 // the size and/or checksum can be verified with [GetDocumentMetadata].
 func (c *Client) DownloadDocumentOriginal(ctx context.Context, w io.Writer, id int64) (*DownloadResult, *Response, error) {
+	ctx = withOperation(ctx, "DownloadDocumentOriginal")
+
 	return c.download(ctx, w, fmt.Sprintf("api/documents/%d/download/?original=true", id), true)
 }
 
@@ -78,11 +90,15 @@ func (c *Client) DownloadDocumentOriginal(ctx context.Context, w io.Writer, id i
 // API may return the original. [DownloadDocumentOriginal] for additional
 // details.
 func (c *Client) DownloadDocumentArchived(ctx context.Context, w io.Writer, id int64) (*DownloadResult, *Response, error) {
+	ctx = withOperation(ctx, "DownloadDocumentArchived")
+
 	return c.download(ctx, w, fmt.Sprintf("api/documents/%d/download/", id), true)
 }
 
 // DownloadDocumentThumbnail retrieves a preview image of the document. See
 // [DownloadDocumentOriginal] for additional details.
 func (c *Client) DownloadDocumentThumbnail(ctx context.Context, w io.Writer, id int64) (*DownloadResult, *Response, error) {
+	ctx = withOperation(ctx, "DownloadDocumentThumbnail")
+
 	return c.download(ctx, w, fmt.Sprintf("api/documents/%d/thumb/", id), false)
 }
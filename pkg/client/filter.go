@@ -3,6 +3,7 @@ package client
 import (
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -30,6 +31,20 @@ func (o OrderingSpec) EncodeValues(key string, v *url.Values) error {
 	return nil
 }
 
+func formatIDList(ids []int64) string {
+	var buf strings.Builder
+
+	for idx, id := range ids {
+		if idx > 0 {
+			buf.WriteByte(',')
+		}
+
+		buf.WriteString(strconv.FormatInt(id, 10))
+	}
+
+	return buf.String()
+}
+
 // CharFilterSpec contains filters available on character/string fields. All
 // comparison are case-insensitive.
 type CharFilterSpec struct {
@@ -88,10 +103,43 @@ func (s IntFilterSpec) EncodeValues(key string, v *url.Values) error {
 	return nil
 }
 
+// IDFilterSpec contains filters available on object ID fields. The server
+// doesn't support range comparisons on IDs.
+type IDFilterSpec struct {
+	Equals *int64
+
+	// Match any of the given IDs.
+	In []int64
+}
+
+var _ query.Encoder = (*IDFilterSpec)(nil)
+
+func (s IDFilterSpec) EncodeValues(key string, v *url.Values) error {
+	if s.Equals != nil {
+		v.Set(key+"__exact", strconv.FormatInt(*s.Equals, 10))
+	}
+
+	if len(s.In) > 0 {
+		v.Set(key+"__in", formatIDList(s.In))
+	}
+
+	return nil
+}
+
 type ForeignKeyFilterSpec struct {
 	IsNull *bool
 	ID     *int64
 	Name   CharFilterSpec
+
+	// Match items related to any of the given IDs.
+	In []int64
+
+	// Match items related to all of the given IDs. Only supported on
+	// many-to-many relationships such as tags.
+	All []int64
+
+	// Match items related to none of the given IDs.
+	None []int64
 }
 
 var _ query.Encoder = (*ForeignKeyFilterSpec)(nil)
@@ -105,6 +153,16 @@ func (s ForeignKeyFilterSpec) EncodeValues(key string, v *url.Values) error {
 		v.Set(key+"__id", strconv.FormatInt(*s.ID, 10))
 	}
 
+	for suffix, ids := range map[string][]int64{
+		"in":   s.In,
+		"all":  s.All,
+		"none": s.None,
+	} {
+		if len(ids) > 0 {
+			v.Set(key+"__id__"+suffix, formatIDList(ids))
+		}
+	}
+
 	return s.Name.EncodeValues(key+"__name", v)
 }
 
@@ -112,19 +170,60 @@ type DateTimeFilterSpec struct {
 	// Set to a non-nil value to only include newer items.
 	Gt *time.Time
 
+	// Set to a non-nil value to only include items at the same time or newer.
+	Gte *time.Time
+
 	// Set to a non-nil value to only include older items.
 	Lt *time.Time
+
+	// Set to a non-nil value to only include items at the same time or older.
+	Lte *time.Time
+
+	// Compare only the date in the server's timezone. The date is taken
+	// from the given time in its location.
+	DateGt  *time.Time
+	DateGte *time.Time
+	DateLt  *time.Time
+	DateLte *time.Time
+
+	// Match items from the given year, month (1-12) or day of the month.
+	Year  *int
+	Month *int
+	Day   *int
 }
 
 var _ query.Encoder = (*DateTimeFilterSpec)(nil)
 
 func (s DateTimeFilterSpec) EncodeValues(key string, v *url.Values) error {
 	for suffix, value := range map[string]*time.Time{
-		"gt": s.Gt,
-		"lt": s.Lt,
+		"gt":  s.Gt,
+		"gte": s.Gte,
+		"lt":  s.Lt,
+		"lte": s.Lte,
+	} {
+		if value != nil {
+			v.Set(key+"__"+suffix, value.Format(time.RFC3339Nano))
+		}
+	}
+
+	for suffix, value := range map[string]*time.Time{
+		"gt":  s.DateGt,
+		"gte": s.DateGte,
+		"lt":  s.DateLt,
+		"lte": s.DateLte,
+	} {
+		if value != nil {
+			v.Set(key+"__date__"+suffix, value.Format(time.DateOnly))
+		}
+	}
+
+	for suffix, value := range map[string]*int{
+		"year":  s.Year,
+		"month": s.Month,
+		"day":   s.Day,
 	} {
 		if value != nil {
-			v.Set(key+"__"+suffix, value.Format(time.RFC3339))
+			v.Set(key+"__"+suffix, strconv.Itoa(*value))
 		}
 	}
 
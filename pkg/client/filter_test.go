@@ -23,6 +23,10 @@ func TestSpecs(t *testing.T) {
 		Number IntFilterSpec `url:"number"`
 	}
 
+	type FakeID struct {
+		ID IDFilterSpec `url:"id"`
+	}
+
 	type FakeForeignKey struct {
 		Kind ForeignKeyFilterSpec `url:"kind"`
 	}
@@ -91,6 +95,23 @@ func TestSpecs(t *testing.T) {
 				"title__icontains":   []string{"contains"},
 			},
 		},
+		{
+			name:  "id empty",
+			value: FakeID{},
+		},
+		{
+			name: "id",
+			value: FakeID{
+				ID: IDFilterSpec{
+					Equals: Int64(3),
+					In:     []int64{7, 8, 9},
+				},
+			},
+			want: url.Values{
+				"id__exact": []string{"3"},
+				"id__in":    []string{"7,8,9"},
+			},
+		},
 		{
 			name: "int",
 			value: FakeInt{
@@ -118,6 +139,9 @@ func TestSpecs(t *testing.T) {
 				Kind: ForeignKeyFilterSpec{
 					ID:     Int64(123),
 					IsNull: Bool(true),
+					In:     []int64{1, 2},
+					All:    []int64{3, 4},
+					None:   []int64{5},
 					Name: CharFilterSpec{
 						EqualsIgnoringCase:     String("equals"),
 						StartsWithIgnoringCase: String("startswith"),
@@ -129,6 +153,9 @@ func TestSpecs(t *testing.T) {
 			want: url.Values{
 				"kind__id":                []string{"123"},
 				"kind__isnull":            []string{"true"},
+				"kind__id__in":            []string{"1,2"},
+				"kind__id__all":           []string{"3,4"},
+				"kind__id__none":          []string{"5"},
 				"kind__name__iexact":      []string{"equals"},
 				"kind__name__istartswith": []string{"startswith"},
 				"kind__name__iendswith":   []string{"endswith"},
@@ -148,6 +175,53 @@ func TestSpecs(t *testing.T) {
 				"created__gt": []string{"2018-07-09T04:05:06Z"},
 			},
 		},
+		{
+			name: "datetime inclusive",
+			value: FakeDateTime{
+				Created: DateTimeFilterSpec{
+					Gte: Time(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					Lte: Time(time.Date(2021, time.February, 3, 4, 5, 6, 0, time.FixedZone("", 3600))),
+				},
+			},
+			want: url.Values{
+				"created__gte": []string{"2020-01-02T03:04:05Z"},
+				"created__lte": []string{"2021-02-03T04:05:06+01:00"},
+			},
+		},
+		{
+			name: "datetime fractional seconds",
+			value: FakeDateTime{
+				Created: DateTimeFilterSpec{
+					Gte: Time(time.Date(2020, time.January, 2, 3, 4, 5, 123456000, time.UTC)),
+				},
+			},
+			want: url.Values{
+				"created__gte": []string{"2020-01-02T03:04:05.123456Z"},
+			},
+		},
+		{
+			name: "date only",
+			value: FakeDateTime{
+				Created: DateTimeFilterSpec{
+					DateGt:  Time(time.Date(2020, time.January, 1, 23, 59, 0, 0, time.UTC)),
+					DateGte: Time(time.Date(2020, time.February, 2, 0, 0, 0, 0, time.UTC)),
+					DateLt:  Time(time.Date(2020, time.March, 3, 0, 0, 0, 0, time.UTC)),
+					DateLte: Time(time.Date(2020, time.April, 4, 0, 0, 0, 0, time.UTC)),
+					Year:    Int(2020),
+					Month:   Int(4),
+					Day:     Int(30),
+				},
+			},
+			want: url.Values{
+				"created__date__gt":  []string{"2020-01-01"},
+				"created__date__gte": []string{"2020-02-02"},
+				"created__date__lt":  []string{"2020-03-03"},
+				"created__date__lte": []string{"2020-04-04"},
+				"created__year":      []string{"2020"},
+				"created__month":     []string{"4"},
+				"created__day":       []string{"30"},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			got, err := query.Values(tc.value)
@@ -164,3 +238,52 @@ func TestSpecs(t *testing.T) {
 		})
 	}
 }
+
+func TestListDocumentsOptionsEncode(t *testing.T) {
+	got, err := query.Values(ListDocumentsOptions{
+		ID:    IDFilterSpec{In: []int64{1, 2, 3}},
+		Title: CharFilterSpec{ContainsIgnoringCase: String("invoice")},
+		Tags: ForeignKeyFilterSpec{
+			All:  []int64{10, 11},
+			None: []int64{12},
+		},
+		Correspondent: ForeignKeyFilterSpec{In: []int64{4, 5}},
+		Created: DateTimeFilterSpec{
+			DateGte: Time(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			DateLte: Time(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)),
+		},
+		CustomFields:    ForeignKeyFilterSpec{All: []int64{7}},
+		IsTagged:        Bool(true),
+		IsInInbox:       Bool(false),
+		HasCustomFields: Bool(true),
+		TitleContent:    String("acme"),
+		MimeType:        String("application/pdf"),
+		Checksum:        CharFilterSpec{EqualsIgnoringCase: String("d41d8cd98f00b204e9800998ecf8427e")},
+	})
+	if err != nil {
+		t.Fatalf("query.Values() failed: %v", err)
+	}
+
+	want := url.Values{
+		"page":                   []string{"1"},
+		"page_size":              []string{"25"},
+		"id__in":                 []string{"1,2,3"},
+		"title__icontains":       []string{"invoice"},
+		"tags__id__all":          []string{"10,11"},
+		"tags__id__none":         []string{"12"},
+		"correspondent__id__in":  []string{"4,5"},
+		"created__date__gte":     []string{"2024-01-01"},
+		"created__date__lte":     []string{"2024-12-31"},
+		"custom_fields__id__all": []string{"7"},
+		"is_tagged":              []string{"true"},
+		"is_in_inbox":            []string{"false"},
+		"has_custom_fields":      []string{"true"},
+		"title_content":          []string{"acme"},
+		"mime_type":              []string{"application/pdf"},
+		"checksum__iexact":       []string{"d41d8cd98f00b204e9800998ecf8427e"},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("Encoded query diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=FilterRuleType -trimprefix=FilterRule -output=filterrule_string.go
+type FilterRuleType int
+
+// Rule types for selecting documents in saved views.
+const (
+	FilterRuleTitleContains FilterRuleType = iota
+	FilterRuleContentContains
+	FilterRuleASNIs
+	FilterRuleCorrespondentIs
+	FilterRuleDocumentTypeIs
+	FilterRuleIsInInbox
+	FilterRuleHasTag
+	FilterRuleHasAnyTag
+	FilterRuleCreatedBefore
+	FilterRuleCreatedAfter
+	FilterRuleCreatedYearIs
+	FilterRuleCreatedMonthIs
+	FilterRuleCreatedDayIs
+	FilterRuleAddedBefore
+	FilterRuleAddedAfter
+	FilterRuleModifiedBefore
+	FilterRuleModifiedAfter
+	FilterRuleDoesNotHaveTag
+	FilterRuleDoesNotHaveASN
+	FilterRuleTitleOrContentContains
+	FilterRuleFulltextQuery
+	FilterRuleMoreLikeThis
+	FilterRuleHasTagsIn
+	FilterRuleASNGreaterThan
+	FilterRuleASNLessThan
+	FilterRuleStoragePathIs
+	FilterRuleHasCorrespondentIn
+	FilterRuleDoesNotHaveCorrespondentIn
+	FilterRuleHasDocumentTypeIn
+	FilterRuleDoesNotHaveDocumentTypeIn
+	FilterRuleHasStoragePathIn
+	FilterRuleDoesNotHaveStoragePathIn
+	FilterRuleOwnerIs
+	FilterRuleHasOwnerIn
+	FilterRuleDoesNotHaveOwner
+	FilterRuleDoesNotHaveOwnerIn
+	FilterRuleHasCustomFieldValue
+	FilterRuleIsSharedByMe
+	FilterRuleHasCustomFields
+	FilterRuleHasCustomFieldIn
+	FilterRuleDoesNotHaveCustomFieldIn
+	FilterRuleDoesNotHaveCustomField
+	FilterRuleCustomFieldQuery
+	FilterRuleCreatedTo
+	FilterRuleCreatedFrom
+	FilterRuleAddedTo
+	FilterRuleAddedFrom
+	FilterRuleMimeTypeIs
+)
+
+// Layout of dates in filter rule values.
+const filterRuleDateLayout = "2006-01-02"
+
+// FilterRule selects documents in a saved view. The value is always
+// transmitted as a string; its interpretation depends on the rule type.
+type FilterRule struct {
+	RuleType FilterRuleType `json:"rule_type"`
+	Value    *string        `json:"value"`
+}
+
+// NewFilterRule returns a rule with a string value.
+func NewFilterRule(t FilterRuleType, value string) FilterRule {
+	return FilterRule{RuleType: t, Value: String(value)}
+}
+
+// NewFilterRuleID returns a rule referring to an object ID, e.g. for
+// [FilterRuleHasTag].
+func NewFilterRuleID(t FilterRuleType, id int64) FilterRule {
+	return NewFilterRule(t, strconv.FormatInt(id, 10))
+}
+
+// NewFilterRuleBool returns a rule with a boolean value, e.g. for
+// [FilterRuleHasAnyTag].
+func NewFilterRuleBool(t FilterRuleType, value bool) FilterRule {
+	return NewFilterRule(t, strconv.FormatBool(value))
+}
+
+// NewFilterRuleDate returns a rule with a date value, e.g. for
+// [FilterRuleCreatedAfter]. The time of day is ignored.
+func NewFilterRuleDate(t FilterRuleType, value time.Time) FilterRule {
+	return NewFilterRule(t, value.Format(filterRuleDateLayout))
+}
+
+func (r FilterRule) value() (string, error) {
+	if r.Value == nil {
+		return "", fmt.Errorf("filter rule %s has no value", r.RuleType)
+	}
+
+	return *r.Value, nil
+}
+
+// IDValue parses the value as an object ID.
+func (r FilterRule) IDValue() (int64, error) {
+	value, err := r.value()
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// BoolValue parses the value as a boolean.
+func (r FilterRule) BoolValue() (bool, error) {
+	value, err := r.value()
+	if err != nil {
+		return false, err
+	}
+
+	return strconv.ParseBool(value)
+}
+
+// DateValue parses the value as a date in the given location.
+func (r FilterRule) DateValue(loc *time.Location) (time.Time, error) {
+	value, err := r.value()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.ParseInLocation(filterRuleDateLayout, value, loc)
+}
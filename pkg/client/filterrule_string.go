@@ -0,0 +1,71 @@
+// Code generated by "stringer -type=FilterRuleType -trimprefix=FilterRule -output=filterrule_string.go"; DO NOT EDIT.
+
+package client
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[FilterRuleTitleContains-0]
+	_ = x[FilterRuleContentContains-1]
+	_ = x[FilterRuleASNIs-2]
+	_ = x[FilterRuleCorrespondentIs-3]
+	_ = x[FilterRuleDocumentTypeIs-4]
+	_ = x[FilterRuleIsInInbox-5]
+	_ = x[FilterRuleHasTag-6]
+	_ = x[FilterRuleHasAnyTag-7]
+	_ = x[FilterRuleCreatedBefore-8]
+	_ = x[FilterRuleCreatedAfter-9]
+	_ = x[FilterRuleCreatedYearIs-10]
+	_ = x[FilterRuleCreatedMonthIs-11]
+	_ = x[FilterRuleCreatedDayIs-12]
+	_ = x[FilterRuleAddedBefore-13]
+	_ = x[FilterRuleAddedAfter-14]
+	_ = x[FilterRuleModifiedBefore-15]
+	_ = x[FilterRuleModifiedAfter-16]
+	_ = x[FilterRuleDoesNotHaveTag-17]
+	_ = x[FilterRuleDoesNotHaveASN-18]
+	_ = x[FilterRuleTitleOrContentContains-19]
+	_ = x[FilterRuleFulltextQuery-20]
+	_ = x[FilterRuleMoreLikeThis-21]
+	_ = x[FilterRuleHasTagsIn-22]
+	_ = x[FilterRuleASNGreaterThan-23]
+	_ = x[FilterRuleASNLessThan-24]
+	_ = x[FilterRuleStoragePathIs-25]
+	_ = x[FilterRuleHasCorrespondentIn-26]
+	_ = x[FilterRuleDoesNotHaveCorrespondentIn-27]
+	_ = x[FilterRuleHasDocumentTypeIn-28]
+	_ = x[FilterRuleDoesNotHaveDocumentTypeIn-29]
+	_ = x[FilterRuleHasStoragePathIn-30]
+	_ = x[FilterRuleDoesNotHaveStoragePathIn-31]
+	_ = x[FilterRuleOwnerIs-32]
+	_ = x[FilterRuleHasOwnerIn-33]
+	_ = x[FilterRuleDoesNotHaveOwner-34]
+	_ = x[FilterRuleDoesNotHaveOwnerIn-35]
+	_ = x[FilterRuleHasCustomFieldValue-36]
+	_ = x[FilterRuleIsSharedByMe-37]
+	_ = x[FilterRuleHasCustomFields-38]
+	_ = x[FilterRuleHasCustomFieldIn-39]
+	_ = x[FilterRuleDoesNotHaveCustomFieldIn-40]
+	_ = x[FilterRuleDoesNotHaveCustomField-41]
+	_ = x[FilterRuleCustomFieldQuery-42]
+	_ = x[FilterRuleCreatedTo-43]
+	_ = x[FilterRuleCreatedFrom-44]
+	_ = x[FilterRuleAddedTo-45]
+	_ = x[FilterRuleAddedFrom-46]
+	_ = x[FilterRuleMimeTypeIs-47]
+}
+
+const _FilterRuleType_name = "TitleContainsContentContainsASNIsCorrespondentIsDocumentTypeIsIsInInboxHasTagHasAnyTagCreatedBeforeCreatedAfterCreatedYearIsCreatedMonthIsCreatedDayIsAddedBeforeAddedAfterModifiedBeforeModifiedAfterDoesNotHaveTagDoesNotHaveASNTitleOrContentContainsFulltextQueryMoreLikeThisHasTagsInASNGreaterThanASNLessThanStoragePathIsHasCorrespondentInDoesNotHaveCorrespondentInHasDocumentTypeInDoesNotHaveDocumentTypeInHasStoragePathInDoesNotHaveStoragePathInOwnerIsHasOwnerInDoesNotHaveOwnerDoesNotHaveOwnerInHasCustomFieldValueIsSharedByMeHasCustomFieldsHasCustomFieldInDoesNotHaveCustomFieldInDoesNotHaveCustomFieldCustomFieldQueryCreatedToCreatedFromAddedToAddedFromMimeTypeIs"
+
+var _FilterRuleType_index = [...]uint16{0, 13, 28, 33, 48, 62, 71, 77, 86, 99, 111, 124, 138, 150, 161, 171, 185, 198, 212, 226, 248, 261, 273, 282, 296, 307, 320, 338, 364, 381, 406, 422, 446, 453, 463, 479, 497, 516, 528, 543, 559, 583, 605, 621, 630, 641, 648, 657, 667}
+
+func (i FilterRuleType) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_FilterRuleType_index)-1 {
+		return "FilterRuleType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _FilterRuleType_name[_FilterRuleType_index[idx]:_FilterRuleType_index[idx+1]]
+}
@@ -38,6 +38,12 @@ type Flags struct {
 	// Number of concurrent requests allowed to be in flight.
 	MaxConcurrentRequests int
 
+	// Maximum number of requests per second.
+	MaxRequestsPerSecond float64
+
+	// Maximum number of retries for failed idempotent requests.
+	MaxRetries int
+
 	// Authenticate via token.
 	AuthToken string
 
@@ -143,9 +149,13 @@ func (f *Flags) BuildOptions() (*Options, error) {
 	opts := &Options{
 		BaseURL:               f.BaseURL,
 		MaxConcurrentRequests: f.MaxConcurrentRequests,
-		DebugMode:             f.DebugMode,
-		Header:                http.Header{},
-		ServerLocation:        time.Local,
+		MaxRequestsPerSecond:  f.MaxRequestsPerSecond,
+		Retry: RetryPolicy{
+			MaxRetries: f.MaxRetries,
+		},
+		DebugMode:      f.DebugMode,
+		Header:         http.Header{},
+		ServerLocation: time.Local,
 	}
 
 	if pool, err := f.buildTrustedRootCAPool(); err != nil {
@@ -98,6 +98,32 @@ func TestFlagsBuild(t *testing.T) {
 			},
 			wantErr: os.ErrNotExist,
 		},
+		{
+			name: "retries",
+			flags: Flags{
+				BaseURL:    "http://localhost/retries",
+				MaxRetries: 4,
+			},
+			want: Options{
+				BaseURL:        "http://localhost/retries",
+				ServerLocation: time.Local,
+				Retry: RetryPolicy{
+					MaxRetries: 4,
+				},
+			},
+		},
+		{
+			name: "rate limit",
+			flags: Flags{
+				BaseURL:              "http://localhost/ratelimit",
+				MaxRequestsPerSecond: 10,
+			},
+			want: Options{
+				BaseURL:              "http://localhost/ratelimit",
+				ServerLocation:       time.Local,
+				MaxRequestsPerSecond: 10,
+			},
+		},
 		{
 			name: "explicit timezone",
 			flags: Flags{
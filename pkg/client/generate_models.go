@@ -7,6 +7,7 @@ import (
 	"flag"
 	"fmt"
 	"go/format"
+	"go/token"
 	"io"
 	"log"
 	"os"
@@ -23,6 +24,7 @@ type modelField struct {
 	comment   string
 	readOnly  bool
 	writeOnly bool
+	omitEmpty bool
 }
 
 type model struct {
@@ -59,7 +61,13 @@ func (o model) write(w io.Writer) {
 			fmt.Fprintf(w, "  // %s\n", strings.TrimSpace(f.comment))
 		}
 
-		fmt.Fprintf(w, "  %s %s `json:%q`\n", name, f.typ, f.name)
+		tag := f.name
+
+		if f.omitEmpty {
+			tag += ",omitempty"
+		}
+
+		fmt.Fprintf(w, "  %s %s `json:%q`\n", name, f.typ, tag)
 	}
 
 	fmt.Fprintf(w, "}\n")
@@ -84,6 +92,10 @@ func (o model) write(w io.Writer) {
 
 		argName := strcase.ToLowerCamel(f.name)
 
+		if token.IsKeyword(argName) {
+			argName += "Value"
+		}
+
 		funcName := fmt.Sprintf("Set%s", strcase.ToCamel(f.name))
 
 		fmt.Fprintf(w, "\n")
@@ -134,7 +146,8 @@ var customFieldModel = model{
 	fields: []modelField{
 		{name: "id", typ: "int64", readOnly: true},
 		{name: "name", typ: "string"},
-		{name: "data_type", typ: "string"},
+		{name: "data_type", typ: "CustomFieldDataType"},
+		{name: "extra_data", typ: "*CustomFieldExtraData", comment: "Additional settings depending on the data type."},
 	},
 }
 
@@ -156,6 +169,20 @@ var documentModel = model{
 		{name: "original_file_name", typ: "string", comment: "Verbose filename of the original document.", readOnly: true},
 		{name: "archived_file_name", typ: "*string", comment: "Verbose filename of the archived document. Nil if no archived document is available.", readOnly: true},
 		{name: "custom_fields", typ: "[]CustomFieldInstance", comment: "Custom fields on the document."},
+		{name: "deleted_at", typ: "*time.Time", comment: "The time at which the document was moved to the trash. Nil unless retrieved from the trash.", readOnly: true},
+		{name: "__search_hit__", typ: "*SearchHit", comment: "Details on the match of a full-text search. Nil if the document wasn't retrieved with a search query.", readOnly: true},
+	},
+}
+
+var shareLinkModel = model{
+	name: "shareLink",
+	fields: []modelField{
+		{name: "id", typ: "int64", readOnly: true},
+		{name: "created", typ: "time.Time", readOnly: true},
+		{name: "expiration", typ: "*time.Time", comment: "Time after which the link can no longer be used. Nil if the link doesn't expire."},
+		{name: "slug", typ: "string", comment: "Random identifier used in the public URL.", readOnly: true},
+		{name: "document", typ: "int64", comment: "ID of the shared document."},
+		{name: "file_version", typ: "ShareLinkFileVersion", comment: "Version of the document file made available."},
 	},
 }
 
@@ -173,6 +200,71 @@ var storagePathModel = model{
 	},
 }
 
+var mailAccountModel = model{
+	name:  "mailAccount",
+	owned: true,
+	fields: []modelField{
+		{name: "id", typ: "int64", readOnly: true},
+		{name: "name", typ: "string"},
+		{name: "imap_server", typ: "string"},
+		{name: "imap_port", typ: "*int64", comment: "IMAP port or nil for the default port of the security mode."},
+		{name: "imap_security", typ: "MailAccountIMAPSecurity"},
+		{name: "username", typ: "string"},
+		{name: "password", typ: "string", comment: "Password or OAuth token. Masked when retrieved from the server."},
+		{name: "character_set", typ: "string", comment: "Character set for communicating with the server, e.g. \"UTF-8\"."},
+		{name: "is_token", typ: "bool", comment: "Whether the password is an OAuth token."},
+		{name: "account_type", typ: "MailAccountType"},
+		{name: "expiration", typ: "*time.Time", comment: "Expiration of the OAuth token, if any.", readOnly: true},
+	},
+}
+
+var mailRuleModel = model{
+	name:  "mailRule",
+	owned: true,
+	fields: []modelField{
+		{name: "id", typ: "int64", readOnly: true},
+		{name: "name", typ: "string"},
+		{name: "account", typ: "int64", comment: "ID of the mail account."},
+		{name: "enabled", typ: "bool"},
+		{name: "folder", typ: "string", comment: "IMAP folder, e.g. \"INBOX\". Subfolders are separated by the server-specific delimiter."},
+		{name: "filter_from", typ: "*string"},
+		{name: "filter_to", typ: "*string"},
+		{name: "filter_subject", typ: "*string"},
+		{name: "filter_body", typ: "*string"},
+		{name: "filter_attachment_filename_include", typ: "*string", comment: "Only consume attachments whose name matches this pattern (case-insensitive, wildcards allowed)."},
+		{name: "filter_attachment_filename_exclude", typ: "*string", comment: "Do not consume attachments whose name matches this pattern (case-insensitive, wildcards allowed)."},
+		{name: "maximum_age", typ: "int64", comment: "Maximum age of messages in days."},
+		{name: "action", typ: "MailRuleAction", comment: "Action applied to messages after processing."},
+		{name: "action_parameter", typ: "*string", comment: "Parameter for the action, e.g. the destination folder for [MailRuleActionMove]."},
+		{name: "assign_title_from", typ: "MailRuleTitleSource"},
+		{name: "assign_tags", typ: "[]int64"},
+		{name: "assign_correspondent_from", typ: "MailRuleCorrespondentSource"},
+		{name: "assign_correspondent", typ: "*int64", comment: "Correspondent for [MailRuleCorrespondentFromCustom]."},
+		{name: "assign_document_type", typ: "*int64"},
+		{name: "assign_owner_from_rule", typ: "bool", comment: "Assign the owner of the rule to consumed documents."},
+		{name: "order", typ: "int64"},
+		{name: "attachment_type", typ: "MailRuleAttachmentType"},
+		{name: "consumption_scope", typ: "MailRuleConsumptionScope"},
+	},
+}
+
+var savedViewModel = model{
+	name:  "savedView",
+	owned: true,
+	fields: []modelField{
+		{name: "id", typ: "int64", readOnly: true},
+		{name: "name", typ: "string"},
+		{name: "show_on_dashboard", typ: "bool", comment: "Show the view on the dashboard."},
+		{name: "show_in_sidebar", typ: "bool", comment: "Show the view in the navigation sidebar."},
+		{name: "sort_field", typ: "*string", comment: "Field by which documents are sorted or nil for the default order."},
+		{name: "sort_reverse", typ: "bool", comment: "Sort documents in descending order."},
+		{name: "filter_rules", typ: "[]FilterRule", comment: "Rules selecting the documents shown by the view."},
+		{name: "page_size", typ: "*int64", comment: "Number of documents per page or nil for the default."},
+		{name: "display_mode", typ: "*string", comment: "Display mode, e.g. \"table\" or \"smallCards\"."},
+		{name: "display_fields", typ: "[]string", comment: "Fields to display, e.g. \"title\" or \"tag\"."},
+	},
+}
+
 var tagModel = model{
 	name:  "tag",
 	owned: true,
@@ -218,6 +310,79 @@ var userModel = model{
 	},
 }
 
+var workflowModel = model{
+	name: "workflow",
+	fields: []modelField{
+		{name: "id", typ: "int64", readOnly: true},
+		{name: "name", typ: "string"},
+		{name: "order", typ: "int64"},
+		{name: "enabled", typ: "bool"},
+		{name: "triggers", typ: "[]WorkflowTrigger", comment: "Triggers starting the workflow. Triggers without ID are created."},
+		{name: "actions", typ: "[]WorkflowAction", comment: "Actions executed in order. Actions without ID are created."},
+	},
+}
+
+var workflowTriggerModel = model{
+	name: "workflowTrigger",
+	fields: []modelField{
+		{name: "id", typ: "int64", comment: "ID of the trigger. Zero for new triggers.", readOnly: true, omitEmpty: true},
+		{name: "type", typ: "WorkflowTriggerType"},
+		{name: "sources", typ: "[]WorkflowTriggerSource", comment: "Document sources for consumption triggers."},
+		{name: "filter_path", typ: "*string", comment: "Only consume documents with a path matching this pattern (wildcards allowed)."},
+		{name: "filter_filename", typ: "*string", comment: "Only consume documents with a filename matching this pattern (wildcards allowed)."},
+		{name: "filter_mailrule", typ: "*int64", comment: "Only consume documents fetched by this mail rule."},
+		{name: "matching_algorithm", typ: "MatchingAlgorithm"},
+		{name: "match", typ: "string"},
+		{name: "is_insensitive", typ: "bool"},
+		{name: "filter_has_tags", typ: "[]int64"},
+		{name: "filter_has_correspondent", typ: "*int64"},
+		{name: "filter_has_document_type", typ: "*int64"},
+		{name: "schedule_offset_days", typ: "int64", comment: "Number of days to offset the schedule trigger by."},
+		{name: "schedule_is_recurring", typ: "bool"},
+		{name: "schedule_recurring_interval_days", typ: "int64"},
+		{name: "schedule_date_field", typ: "WorkflowTriggerScheduleDateField"},
+		{name: "schedule_date_custom_field", typ: "*int64", comment: "Custom field for [WorkflowScheduleDateCustomField]."},
+	},
+}
+
+var workflowActionModel = model{
+	name: "workflowAction",
+	fields: []modelField{
+		{name: "id", typ: "int64", comment: "ID of the action. Zero for new actions.", readOnly: true, omitEmpty: true},
+		{name: "type", typ: "WorkflowActionType"},
+		{name: "assign_title", typ: "*string", comment: "Title to assign; may contain placeholders."},
+		{name: "assign_tags", typ: "[]int64"},
+		{name: "assign_correspondent", typ: "*int64"},
+		{name: "assign_document_type", typ: "*int64"},
+		{name: "assign_storage_path", typ: "*int64"},
+		{name: "assign_owner", typ: "*int64"},
+		{name: "assign_view_users", typ: "[]int64"},
+		{name: "assign_view_groups", typ: "[]int64"},
+		{name: "assign_change_users", typ: "[]int64"},
+		{name: "assign_change_groups", typ: "[]int64"},
+		{name: "assign_custom_fields", typ: "[]int64"},
+		{name: "remove_tags", typ: "[]int64"},
+		{name: "remove_all_tags", typ: "bool"},
+		{name: "remove_correspondents", typ: "[]int64"},
+		{name: "remove_all_correspondents", typ: "bool"},
+		{name: "remove_document_types", typ: "[]int64"},
+		{name: "remove_all_document_types", typ: "bool"},
+		{name: "remove_storage_paths", typ: "[]int64"},
+		{name: "remove_all_storage_paths", typ: "bool"},
+		{name: "remove_custom_fields", typ: "[]int64"},
+		{name: "remove_all_custom_fields", typ: "bool"},
+		{name: "remove_owners", typ: "[]int64"},
+		{name: "remove_all_owners", typ: "bool"},
+		{name: "remove_view_users", typ: "[]int64"},
+		{name: "remove_view_groups", typ: "[]int64"},
+		{name: "remove_change_users", typ: "[]int64"},
+		{name: "remove_change_groups", typ: "[]int64"},
+		{name: "remove_all_permissions", typ: "bool"},
+		{name: "email", typ: "*WorkflowActionEmail", comment: "Settings for email actions."},
+		{name: "webhook", typ: "*WorkflowActionWebhook", comment: "Settings for webhook actions."},
+	},
+}
+
 var groupModel = model{
 	name: "group",
 	fields: []modelField{
@@ -259,10 +424,17 @@ func main() {
 		customFieldModel,
 		documentModel,
 		documentTypeModel,
+		mailAccountModel,
+		mailRuleModel,
+		savedViewModel,
+		shareLinkModel,
 		storagePathModel,
 		tagModel,
 		userModel,
 		groupModel,
+		workflowModel,
+		workflowTriggerModel,
+		workflowActionModel,
 	}
 
 	sort.Slice(models, func(a, b int) bool {
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 )
 
 func (c *Client) groupCrudOpts() crudOptions {
@@ -25,15 +26,29 @@ type ListGroupsOptions struct {
 }
 
 func (c *Client) ListGroups(ctx context.Context, opts ListGroupsOptions) ([]Group, *Response, error) {
+	ctx = withOperation(ctx, "ListGroups")
+
 	return crudList[Group](ctx, c.groupCrudOpts(), opts)
 }
 
 // ListAllGroups iterates over all groups matching the filters specified in opts,
 // invoking handler for each.
 func (c *Client) ListAllGroups(ctx context.Context, opts ListGroupsOptions, handler func(context.Context, Group) error) error {
+	ctx = withOperation(ctx, "ListAllGroups")
+
 	return crudListAll[Group](ctx, c.groupCrudOpts(), opts, handler)
 }
 
+// IterAllGroups returns an iterator over all groups matching the filters
+// specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllGroups(ctx context.Context, opts ListGroupsOptions) iter.Seq2[Group, error] {
+	ctx = withOperation(ctx, "IterAllGroups")
+
+	return crudIterAll[Group](ctx, c.groupCrudOpts(), opts)
+}
+
 func (c *Client) GetGroup(ctx context.Context, id int64) (*Group, *Response, error) {
+	ctx = withOperation(ctx, "GetGroup")
+
 	return crudGet[Group](ctx, c.groupCrudOpts(), id)
 }
@@ -0,0 +1,325 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type DocumentHistoryAction string
+
+const (
+	DocumentHistoryCreate DocumentHistoryAction = "create"
+	DocumentHistoryUpdate DocumentHistoryAction = "update"
+	DocumentHistoryDelete DocumentHistoryAction = "delete"
+)
+
+type DocumentHistoryActor struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+// Types of changes which are not a simple before/after change of a field
+// value.
+const (
+	DocumentHistoryChangeManyToMany  = "m2m"
+	DocumentHistoryChangeCustomField = "custom_field"
+)
+
+// DocumentHistoryChange describes the change of a single field.
+type DocumentHistoryChange struct {
+	// Empty for changes of a field value, otherwise one of
+	// [DocumentHistoryChangeManyToMany] or [DocumentHistoryChangeCustomField].
+	Type string
+
+	// Field values before and after the change. Nil for empty values.
+	Before *string
+	After  *string
+
+	// Operation on a many-to-many relationship ("add" or "remove") and the
+	// names of the affected objects.
+	Operation string
+	Objects   []string
+
+	// Name and new value of a custom field.
+	Field string
+	Value string
+}
+
+var _ json.Unmarshaler = (*DocumentHistoryChange)(nil)
+
+func (c *DocumentHistoryChange) UnmarshalJSON(data []byte) error {
+	var values []*string
+
+	if err := json.Unmarshal(data, &values); err == nil {
+		if len(values) != 2 {
+			return fmt.Errorf("field change must have two values, got %d", len(values))
+		}
+
+		*c = DocumentHistoryChange{}
+
+		for idx, dest := range []**string{&c.Before, &c.After} {
+			// Empty values are stored as the string "None".
+			if v := values[idx]; !(v == nil || *v == "None") {
+				*dest = v
+			}
+		}
+
+		return nil
+	}
+
+	var obj struct {
+		Type      string   `json:"type"`
+		Operation string   `json:"operation"`
+		Objects   []string `json:"objects"`
+		Field     string   `json:"field"`
+		Value     string   `json:"value"`
+	}
+
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return fmt.Errorf("unrecognized history change: %w", err)
+	}
+
+	*c = DocumentHistoryChange{
+		Type:      obj.Type,
+		Operation: obj.Operation,
+		Objects:   obj.Objects,
+		Field:     obj.Field,
+		Value:     obj.Value,
+	}
+
+	return nil
+}
+
+type DocumentHistoryEntry struct {
+	ID        int64
+	Timestamp time.Time
+	Action    DocumentHistoryAction
+
+	// User who made the change; nil for changes made by the system.
+	Actor *DocumentHistoryActor
+
+	// Changes keyed by field name, e.g. "title" or "tags".
+	Changes map[string]DocumentHistoryChange
+}
+
+type documentHistoryEntryJSON struct {
+	ID        int64                            `json:"id"`
+	Timestamp string                           `json:"timestamp"`
+	Action    DocumentHistoryAction            `json:"action"`
+	Actor     *DocumentHistoryActor            `json:"actor"`
+	Changes   map[string]DocumentHistoryChange `json:"changes"`
+}
+
+// GetDocumentHistory retrieves the audit log of a document, newest entries
+// first. The audit log must be enabled on the server.
+func (c *Client) GetDocumentHistory(ctx context.Context, id int64) ([]DocumentHistoryEntry, *Response, error) {
+	ctx = withOperation(ctx, "GetDocumentHistory")
+
+	resp, err := c.newRequest(ctx).
+		SetResult([]documentHistoryEntryJSON(nil)).
+		Get(fmt.Sprintf("api/documents/%d/history/", id))
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	var result []DocumentHistoryEntry
+
+	for _, i := range *resp.Result().(*[]documentHistoryEntryJSON) {
+		ts, err := parseServerTime(i.Timestamp, c.loc)
+		if err != nil {
+			return nil, wrapResponse(resp), fmt.Errorf("history entry %d: %w", i.ID, err)
+		}
+
+		result = append(result, DocumentHistoryEntry{
+			ID:        i.ID,
+			Timestamp: ts,
+			Action:    i.Action,
+			Actor:     i.Actor,
+			Changes:   i.Changes,
+		})
+	}
+
+	return result, wrapResponse(resp), nil
+}
+
+// ErrDocumentNotCreated is returned by [RebuildDocument] when the document
+// didn't exist at the requested time.
+var ErrDocumentNotCreated = errors.New("document was created after the requested time")
+
+// ErrCustomFieldNotReverted is returned by [RebuildDocument] when a custom
+// field value was modified or removed after the requested time. The history
+// only records the new value as text, so the previous value can't be restored.
+var ErrCustomFieldNotReverted = errors.New("custom field value change can't be reverted")
+
+func historyIntValue(value *string) (*int64, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	num, err := strconv.ParseInt(*value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &num, nil
+}
+
+func revertDocumentChange(doc *Document, field string, change DocumentHistoryChange, tagIDs map[string]int64) error {
+	switch change.Type {
+	case "":
+	case DocumentHistoryChangeManyToMany:
+		if field != "tags" {
+			return nil
+		}
+
+		for _, name := range change.Objects {
+			id, ok := tagIDs[name]
+			if !ok {
+				return fmt.Errorf("unknown tag %q", name)
+			}
+
+			switch change.Operation {
+			case "add":
+				doc.Tags = slices.DeleteFunc(doc.Tags, func(v int64) bool {
+					return v == id
+				})
+
+			case "remove":
+				if !slices.Contains(doc.Tags, id) {
+					doc.Tags = append(doc.Tags, id)
+				}
+
+			default:
+				return fmt.Errorf("unknown operation %q", change.Operation)
+			}
+		}
+
+		return nil
+
+	default:
+		// Custom field changes are reverted by revertCustomFieldChange.
+		return nil
+	}
+
+	var err error
+
+	switch field {
+	case "title":
+		doc.Title = ""
+		if change.Before != nil {
+			doc.Title = *change.Before
+		}
+
+	case "content":
+		doc.Content = ""
+		if change.Before != nil {
+			doc.Content = *change.Before
+		}
+
+	case "created":
+		if change.Before != nil {
+			doc.Created, err = parseServerTime(*change.Before, doc.Created.Location())
+		}
+
+	case "archive_serial_number":
+		doc.ArchiveSerialNumber, err = historyIntValue(change.Before)
+
+	case "correspondent":
+		doc.Correspondent, err = historyIntValue(change.Before)
+
+	case "document_type":
+		doc.DocumentType, err = historyIntValue(change.Before)
+
+	case "storage_path":
+		doc.StoragePath, err = historyIntValue(change.Before)
+
+	case "owner":
+		doc.Owner, err = historyIntValue(change.Before)
+	}
+
+	return err
+}
+
+func revertCustomFieldChange(doc *Document, action DocumentHistoryAction, change DocumentHistoryChange, fieldIDs map[string]int64) error {
+	if action != DocumentHistoryCreate {
+		return fmt.Errorf("%w: %s of %q", ErrCustomFieldNotReverted, action, change.Field)
+	}
+
+	id, ok := fieldIDs[change.Field]
+	if !ok {
+		return fmt.Errorf("unknown custom field %q", change.Field)
+	}
+
+	doc.CustomFields = slices.DeleteFunc(doc.CustomFields, func(i CustomFieldInstance) bool {
+		return i.Field == id
+	})
+
+	return nil
+}
+
+// hasCustomFieldChange returns whether the entry records a change of a custom
+// field instance. Such entries are part of the document history.
+func hasCustomFieldChange(entry DocumentHistoryEntry) bool {
+	for _, change := range entry.Changes {
+		if change.Type == DocumentHistoryChangeCustomField {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RebuildDocument reconstructs a document as it was at the given point in time
+// by reverting all changes recorded in the history after that time. The
+// history is retrieved with [Client.GetDocumentHistory].
+//
+// The history identifies tags and custom fields by name. tagIDs and fieldIDs
+// map the names to IDs and are required if tags or custom fields were changed.
+// Custom fields added after the given time are removed. Modified or removed
+// custom field values can't be restored and cause an error wrapping
+// [ErrCustomFieldNotReverted]. Fields not stored in the history are left
+// unchanged.
+func RebuildDocument(current *Document, history []DocumentHistoryEntry, at time.Time, tagIDs, fieldIDs map[string]int64) (*Document, error) {
+	doc := *current
+	doc.Tags = slices.Clone(current.Tags)
+	doc.CustomFields = slices.Clone(current.CustomFields)
+
+	history = slices.Clone(history)
+
+	// Revert changes in reverse chronological order.
+	slices.SortStableFunc(history, func(a, b DocumentHistoryEntry) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+
+	for _, entry := range history {
+		if !entry.Timestamp.After(at) {
+			break
+		}
+
+		if entry.Action == DocumentHistoryCreate && !hasCustomFieldChange(entry) {
+			return nil, ErrDocumentNotCreated
+		}
+
+		for field, change := range entry.Changes {
+			var err error
+
+			if change.Type == DocumentHistoryChangeCustomField {
+				err = revertCustomFieldChange(&doc, entry.Action, change, fieldIDs)
+			} else if entry.Action == DocumentHistoryUpdate {
+				err = revertDocumentChange(&doc, field, change, tagIDs)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("history entry %d, field %q: %w", entry.ID, field, err)
+			}
+		}
+	}
+
+	return &doc, nil
+}
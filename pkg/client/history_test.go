@@ -0,0 +1,334 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestDocumentHistoryChangeUnmarshal(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		want    DocumentHistoryChange
+		wantErr error
+	}{
+		{
+			input: `["old", "new"]`,
+			want: DocumentHistoryChange{
+				Before: String("old"),
+				After:  String("new"),
+			},
+		},
+		{
+			input: `["None", "5"]`,
+			want: DocumentHistoryChange{
+				After: String("5"),
+			},
+		},
+		{
+			input: `[null, null]`,
+		},
+		{
+			input: `{"type": "m2m", "operation": "add", "objects": ["Invoice", "Paid"]}`,
+			want: DocumentHistoryChange{
+				Type:      DocumentHistoryChangeManyToMany,
+				Operation: "add",
+				Objects:   []string{"Invoice", "Paid"},
+			},
+		},
+		{
+			input: `{"type": "custom_field", "field": "Amount", "value": "12.50"}`,
+			want: DocumentHistoryChange{
+				Type:  DocumentHistoryChangeCustomField,
+				Field: "Amount",
+				Value: "12.50",
+			},
+		},
+		{input: `["a"]`, wantErr: cmpopts.AnyError},
+		{input: `123`, wantErr: cmpopts.AnyError},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			var got DocumentHistoryChange
+
+			err := json.Unmarshal([]byte(tc.input), &got)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unmarshal() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Unmarshal() diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGetDocumentHistory(t *testing.T) {
+	loc := time.FixedZone("Test", 2*60*60)
+
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodGet, "/api/documents/21/history/",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{
+				"id": 30,
+				"timestamp": "2024-03-01T12:00:00.5+01:00",
+				"action": "update",
+				"changes": {
+					"title": ["Scan", "Invoice"],
+					"tags": {"type": "m2m", "operation": "add", "objects": ["Paid"]}
+				},
+				"actor": {"id": 3, "username": "alice"}
+			},
+			{
+				"id": 29,
+				"timestamp": "2024-02-28T09:30:00",
+				"action": "create",
+				"changes": {"title": ["None", "Scan"]},
+				"actor": null
+			}
+		]`))
+
+	c := New(Options{
+		transport:      transport,
+		ServerLocation: loc,
+	})
+
+	got, _, err := c.GetDocumentHistory(context.Background(), 21)
+	if err != nil {
+		t.Fatalf("GetDocumentHistory() failed: %v", err)
+	}
+
+	want := []DocumentHistoryEntry{
+		{
+			ID:        30,
+			Timestamp: time.Date(2024, time.March, 1, 11, 0, 0, 500000000, time.UTC),
+			Action:    DocumentHistoryUpdate,
+			Actor:     &DocumentHistoryActor{ID: 3, Username: "alice"},
+			Changes: map[string]DocumentHistoryChange{
+				"title": {Before: String("Scan"), After: String("Invoice")},
+				"tags": {
+					Type:      DocumentHistoryChangeManyToMany,
+					Operation: "add",
+					Objects:   []string{"Paid"},
+				},
+			},
+		},
+		{
+			ID:        29,
+			Timestamp: time.Date(2024, time.February, 28, 9, 30, 0, 0, loc),
+			Action:    DocumentHistoryCreate,
+			Changes: map[string]DocumentHistoryChange{
+				"title": {After: String("Scan")},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty(), cmpopts.EquateApproxTime(0)); diff != "" {
+		t.Errorf("GetDocumentHistory() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRebuildDocument(t *testing.T) {
+	created := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	current := &Document{
+		ID:            21,
+		Title:         "Invoice",
+		Created:       created,
+		Correspondent: Int64(5),
+		Tags:          []int64{1, 2},
+		CustomFields: []CustomFieldInstance{
+			NewCustomFieldString(3, "A-1"),
+			NewCustomFieldInteger(4, 12),
+		},
+	}
+
+	history := []DocumentHistoryEntry{
+		{
+			ID:        32,
+			Timestamp: time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+			Action:    DocumentHistoryCreate,
+			Changes: map[string]DocumentHistoryChange{
+				"custom_fields": {
+					Type:  DocumentHistoryChangeCustomField,
+					Field: "Reference",
+					Value: "A-1",
+				},
+			},
+		},
+		{
+			ID:        29,
+			Timestamp: time.Date(2024, time.February, 28, 9, 30, 0, 0, time.UTC),
+			Action:    DocumentHistoryCreate,
+			Changes: map[string]DocumentHistoryChange{
+				"title": {After: String("Scan")},
+			},
+		},
+		{
+			ID:        31,
+			Timestamp: time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+			Action:    DocumentHistoryUpdate,
+			Changes: map[string]DocumentHistoryChange{
+				"correspondent": {Before: String("4"), After: String("5")},
+				"tags": {
+					Type:      DocumentHistoryChangeManyToMany,
+					Operation: "remove",
+					Objects:   []string{"Inbox"},
+				},
+			},
+		},
+		{
+			ID:        30,
+			Timestamp: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			Action:    DocumentHistoryUpdate,
+			Changes: map[string]DocumentHistoryChange{
+				"title":   {Before: String("Scan"), After: String("Invoice")},
+				"created": {Before: String("2024-01-15"), After: String("2024-02-01")},
+				"tags": {
+					Type:      DocumentHistoryChangeManyToMany,
+					Operation: "add",
+					Objects:   []string{"Paid"},
+				},
+			},
+		},
+	}
+
+	tagIDs := map[string]int64{
+		"Inbox": 9,
+		"Paid":  2,
+	}
+
+	fieldIDs := map[string]int64{
+		"Reference": 3,
+		"Pages":     4,
+	}
+
+	for _, tc := range []struct {
+		name     string
+		at       time.Time
+		extra    []DocumentHistoryEntry
+		tagIDs   map[string]int64
+		fieldIDs map[string]int64
+		want     *Document
+		wantErr  error
+	}{
+		{
+			name:     "current",
+			at:       time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+			tagIDs:   tagIDs,
+			fieldIDs: fieldIDs,
+			want:     current,
+		},
+		{
+			name:     "custom field added",
+			at:       time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC),
+			tagIDs:   tagIDs,
+			fieldIDs: fieldIDs,
+			want: &Document{
+				ID:            21,
+				Title:         "Invoice",
+				Created:       created,
+				Correspondent: Int64(5),
+				Tags:          []int64{1, 2},
+				CustomFields:  []CustomFieldInstance{NewCustomFieldInteger(4, 12)},
+			},
+		},
+		{
+			name:     "between updates",
+			at:       time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+			tagIDs:   tagIDs,
+			fieldIDs: fieldIDs,
+			want: &Document{
+				ID:            21,
+				Title:         "Invoice",
+				Created:       created,
+				Correspondent: Int64(4),
+				Tags:          []int64{1, 2, 9},
+				CustomFields:  []CustomFieldInstance{NewCustomFieldInteger(4, 12)},
+			},
+		},
+		{
+			name:     "after creation",
+			at:       time.Date(2024, time.February, 28, 10, 0, 0, 0, time.UTC),
+			tagIDs:   tagIDs,
+			fieldIDs: fieldIDs,
+			want: &Document{
+				ID:            21,
+				Title:         "Scan",
+				Created:       time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+				Correspondent: Int64(4),
+				Tags:          []int64{1, 9},
+				CustomFields:  []CustomFieldInstance{NewCustomFieldInteger(4, 12)},
+			},
+		},
+		{
+			name:     "before creation",
+			at:       time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			tagIDs:   tagIDs,
+			fieldIDs: fieldIDs,
+			wantErr:  ErrDocumentNotCreated,
+		},
+		{
+			name:     "unknown tag",
+			at:       time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+			fieldIDs: fieldIDs,
+			wantErr:  cmpopts.AnyError,
+		},
+		{
+			name:    "unknown custom field",
+			at:      time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC),
+			tagIDs:  tagIDs,
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "custom field modified",
+			at:   time.Date(2024, time.March, 2, 12, 0, 0, 0, time.UTC),
+			extra: []DocumentHistoryEntry{{
+				ID:        33,
+				Timestamp: time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+				Action:    DocumentHistoryUpdate,
+				Changes: map[string]DocumentHistoryChange{
+					"custom_fields": {
+						Type:  DocumentHistoryChangeCustomField,
+						Field: "Pages",
+						Value: "12",
+					},
+				},
+			}},
+			tagIDs:   tagIDs,
+			fieldIDs: fieldIDs,
+			wantErr:  ErrCustomFieldNotReverted,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := RebuildDocument(current, append(slices.Clone(history), tc.extra...), tc.at, tc.tagIDs, tc.fieldIDs)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("RebuildDocument() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("RebuildDocument() diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+
+	if diff := cmp.Diff([]int64{1, 2}, current.Tags); diff != "" {
+		t.Errorf("Current document was modified (-want +got):\n%s", diff)
+	}
+
+	if len(current.CustomFields) != 2 {
+		t.Errorf("Custom fields of current document were modified: %v", current.CustomFields)
+	}
+}
@@ -12,6 +12,8 @@ import (
 
 // ListLogs retrieves the names of available log files.
 func (c *Client) ListLogs(ctx context.Context) ([]string, *Response, error) {
+	ctx = withOperation(ctx, "ListLogs")
+
 	req := c.newRequest(ctx).SetResult([]string(nil))
 
 	resp, err := req.Get("api/logs/")
@@ -101,6 +103,8 @@ func (p *logParser) parse(lines []string) []LogEntry {
 
 // GetLog retrieves all entries of the named log file.
 func (c *Client) GetLog(ctx context.Context, name string) ([]LogEntry, *Response, error) {
+	ctx = withOperation(ctx, "GetLog")
+
 	req := c.newRequest(ctx).SetResult([]string(nil))
 
 	resp, err := req.Get(fmt.Sprintf("api/logs/%s/", url.PathEscape(name)))
@@ -1,7 +1,12 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"time"
 )
 
 type stdLogger interface {
@@ -14,6 +19,37 @@ type Logger interface {
 	Debugf(format string, v ...any)
 }
 
+// attrLogger is implemented by loggers supporting structured attributes.
+type attrLogger interface {
+	logAttrs(level slog.Level, msg string, attrs []slog.Attr)
+}
+
+// logAttrs writes a message with structured attributes. Loggers without
+// support for attributes receive them formatted as part of the message.
+func logAttrs(l Logger, level slog.Level, msg string, attrs ...slog.Attr) {
+	if al, ok := l.(attrLogger); ok {
+		al.logAttrs(level, msg, attrs)
+		return
+	}
+
+	var buf strings.Builder
+
+	buf.WriteString(msg)
+
+	for _, a := range attrs {
+		fmt.Fprintf(&buf, " %s=%v", a.Key, a.Value)
+	}
+
+	switch {
+	case level >= slog.LevelError:
+		l.Errorf("%s", buf.String())
+	case level >= slog.LevelWarn:
+		l.Warnf("%s", buf.String())
+	default:
+		l.Debugf("%s", buf.String())
+	}
+}
+
 type discardLogger struct{}
 
 var _ Logger = (*discardLogger)(nil)
@@ -71,3 +107,52 @@ func (l *prefixLogger) Warnf(format string, v ...any) {
 func (l *prefixLogger) Debugf(format string, v ...any) {
 	l.wrap(l.wrapped.Debugf, format, v)
 }
+
+// SlogLogger implements [Logger] on top of a [slog.Handler]. Messages logged
+// by the client include structured attributes, e.g. the task ID.
+type SlogLogger struct {
+	handler slog.Handler
+}
+
+var _ Logger = (*SlogLogger)(nil)
+var _ attrLogger = (*SlogLogger)(nil)
+
+func NewSlogLogger(h slog.Handler) *SlogLogger {
+	return &SlogLogger{handler: h}
+}
+
+// log emits a record. The number of stack frames to skip is relative to the
+// caller of log.
+func (l *SlogLogger) log(skip int, level slog.Level, msg string, attrs []slog.Attr) {
+	ctx := context.Background()
+
+	if !l.handler.Enabled(ctx, level) {
+		return
+	}
+
+	var pcs [1]uintptr
+
+	runtime.Callers(skip+2, pcs[:])
+
+	r := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	r.AddAttrs(attrs...)
+
+	_ = l.handler.Handle(ctx, r)
+}
+
+func (l *SlogLogger) logAttrs(level slog.Level, msg string, attrs []slog.Attr) {
+	// Skip the logAttrs helper function.
+	l.log(2, level, msg, attrs)
+}
+
+func (l *SlogLogger) Errorf(format string, v ...any) {
+	l.log(1, slog.LevelError, fmt.Sprintf(format, v...), nil)
+}
+
+func (l *SlogLogger) Warnf(format string, v ...any) {
+	l.log(1, slog.LevelWarn, fmt.Sprintf(format, v...), nil)
+}
+
+func (l *SlogLogger) Debugf(format string, v ...any) {
+	l.log(1, slog.LevelDebug, fmt.Sprintf(format, v...), nil)
+}
@@ -1,8 +1,14 @@
 package client
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -70,3 +76,78 @@ func TestLogger(t *testing.T) {
 		})
 	}
 }
+
+func TestLogAttrsFallback(t *testing.T) {
+	var dest appendLogger
+
+	logger := &wrappedStdLogger{&dest}
+
+	logAttrs(logger, slog.LevelError, "error", slog.Int("n", 1))
+	logAttrs(logger, slog.LevelWarn, "warn", slog.String("url", "/api/"), slog.Duration("delay", 5*time.Second))
+	logAttrs(logger, slog.LevelDebug, "debug", slog.String("task_id", "abc"), slog.Any("error", errors.New("failed")))
+
+	want := []string{
+		"[E] error n=1",
+		"[W] warn url=/api/ delay=5s",
+		"[D] debug task_id=abc error=failed",
+	}
+
+	if diff := cmp.Diff(want, []string(dest)); diff != "" {
+		t.Errorf("Log message diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+
+	h := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		AddSource: true,
+		Level:     slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			switch a.Key {
+			case slog.TimeKey:
+				return slog.Attr{}
+			case slog.SourceKey:
+				src := a.Value.Any().(*slog.Source)
+				return slog.String(a.Key, filepath.Base(src.File))
+			}
+
+			return a
+		},
+	})
+
+	c := New(Options{
+		SlogLogger: slog.New(h).With("component", "test"),
+	})
+
+	c.logger.Errorf("error %d", 1)
+	c.logger.Warnf("warn %d", 2)
+	c.logger.Debugf("debug %d", 3)
+	logAttrs(c.logger, slog.LevelDebug, "Condition not met", slog.String("task_id", "abc"), slog.Duration("delay", time.Second))
+
+	want := []string{
+		`level=ERROR source=logger_test.go msg="error 1" component=test`,
+		`level=WARN source=logger_test.go msg="warn 2" component=test`,
+		`level=DEBUG source=logger_test.go msg="debug 3" component=test`,
+		`level=DEBUG source=logger_test.go msg="Condition not met" component=test task_id=abc delay=1s`,
+	}
+
+	if diff := cmp.Diff(want, strings.Split(strings.TrimSpace(buf.String()), "\n")); diff != "" {
+		t.Errorf("Log message diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestSlogLoggerLevel(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := NewSlogLogger(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	logger.Debugf("hidden")
+	logAttrs(logger, slog.LevelDebug, "hidden")
+
+	if buf.Len() > 0 {
+		t.Errorf("Debug messages were written: %q", buf.String())
+	}
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=MailAccountIMAPSecurity,MailAccountType -output=mailaccount_string.go
+type MailAccountIMAPSecurity int
+
+const (
+	MailAccountIMAPSecurityUnspecified MailAccountIMAPSecurity = iota
+
+	// No encryption.
+	MailAccountIMAPSecurityNone
+
+	// Use SSL/TLS.
+	MailAccountIMAPSecuritySSL
+
+	// Use STARTTLS.
+	MailAccountIMAPSecurityStartTLS
+)
+
+type MailAccountType int
+
+const (
+	MailAccountTypeUnspecified MailAccountType = iota
+
+	// IMAP with username and password.
+	MailAccountTypeIMAP
+
+	// Gmail using OAuth.
+	MailAccountTypeGmailOAuth
+
+	// Outlook using OAuth.
+	MailAccountTypeOutlookOAuth
+)
+
+func (c *Client) mailAccountCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/mail_accounts/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(MailAccount).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListMailAccountsOptions).Page = page
+		},
+	}
+}
+
+type ListMailAccountsOptions struct {
+	ListOptions
+}
+
+func (c *Client) ListMailAccounts(ctx context.Context, opts ListMailAccountsOptions) ([]MailAccount, *Response, error) {
+	ctx = withOperation(ctx, "ListMailAccounts")
+
+	return crudList[MailAccount](ctx, c.mailAccountCrudOpts(), opts)
+}
+
+// ListAllMailAccounts iterates over all mail accounts matching the filters
+// specified in opts, invoking handler for each.
+func (c *Client) ListAllMailAccounts(ctx context.Context, opts ListMailAccountsOptions, handler func(context.Context, MailAccount) error) error {
+	ctx = withOperation(ctx, "ListAllMailAccounts")
+
+	return crudListAll[MailAccount](ctx, c.mailAccountCrudOpts(), opts, handler)
+}
+
+// IterAllMailAccounts returns an iterator over all mail accounts matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllMailAccounts(ctx context.Context, opts ListMailAccountsOptions) iter.Seq2[MailAccount, error] {
+	ctx = withOperation(ctx, "IterAllMailAccounts")
+
+	return crudIterAll[MailAccount](ctx, c.mailAccountCrudOpts(), opts)
+}
+
+func (c *Client) GetMailAccount(ctx context.Context, id int64) (*MailAccount, *Response, error) {
+	ctx = withOperation(ctx, "GetMailAccount")
+
+	return crudGet[MailAccount](ctx, c.mailAccountCrudOpts(), id)
+}
+
+func (c *Client) CreateMailAccount(ctx context.Context, data *MailAccountFields) (*MailAccount, *Response, error) {
+	ctx = withOperation(ctx, "CreateMailAccount")
+
+	return crudCreate[MailAccount](ctx, c.mailAccountCrudOpts(), data)
+}
+
+func (c *Client) UpdateMailAccount(ctx context.Context, id int64, data *MailAccount) (*MailAccount, *Response, error) {
+	ctx = withOperation(ctx, "UpdateMailAccount")
+
+	return crudUpdate[MailAccount](ctx, c.mailAccountCrudOpts(), id, data)
+}
+
+func (c *Client) PatchMailAccount(ctx context.Context, id int64, data *MailAccountFields) (*MailAccount, *Response, error) {
+	ctx = withOperation(ctx, "PatchMailAccount")
+
+	return crudPatch[MailAccount](ctx, c.mailAccountCrudOpts(), id, data)
+}
+
+func (c *Client) DeleteMailAccount(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteMailAccount")
+
+	return crudDelete[MailAccount](ctx, c.mailAccountCrudOpts(), id)
+}
+
+// TestMailAccount verifies that the server can connect to a mail server using
+// the given settings. The account doesn't need to exist. When testing an
+// existing account the stored password is used if id is non-nil and the
+// password isn't changed.
+func (c *Client) TestMailAccount(ctx context.Context, id *int64, data *MailAccountFields) (*Response, error) {
+	ctx = withOperation(ctx, "TestMailAccount")
+
+	type testResult struct {
+		Success bool `json:"success"`
+	}
+
+	body := data.AsMap()
+
+	if id != nil {
+		body["id"] = *id
+	}
+
+	resp, err := c.newRequest(ctx).
+		SetResult(&testResult{}).
+		SetBody(body).
+		Post("api/mail_accounts/test/")
+
+	if err := convertError(err, resp); err != nil {
+		return wrapResponse(resp), err
+	}
+
+	if !resp.Result().(*testResult).Success {
+		return wrapResponse(resp), errors.New("mail account test was not successful")
+	}
+
+	return wrapResponse(resp), nil
+}
+
+// ProcessMailAccount schedules the immediate processing of all rules of
+// a mail account.
+func (c *Client) ProcessMailAccount(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "ProcessMailAccount")
+
+	resp, err := c.newRequest(ctx).
+		Post(fmt.Sprintf("api/mail_accounts/%d/process/", id))
+
+	return wrapResponse(resp), convertError(err, resp)
+}
@@ -0,0 +1,48 @@
+// Code generated by "stringer -type=MailAccountIMAPSecurity,MailAccountType -output=mailaccount_string.go"; DO NOT EDIT.
+
+package client
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MailAccountIMAPSecurityUnspecified-0]
+	_ = x[MailAccountIMAPSecurityNone-1]
+	_ = x[MailAccountIMAPSecuritySSL-2]
+	_ = x[MailAccountIMAPSecurityStartTLS-3]
+}
+
+const _MailAccountIMAPSecurity_name = "MailAccountIMAPSecurityUnspecifiedMailAccountIMAPSecurityNoneMailAccountIMAPSecuritySSLMailAccountIMAPSecurityStartTLS"
+
+var _MailAccountIMAPSecurity_index = [...]uint8{0, 34, 61, 87, 118}
+
+func (i MailAccountIMAPSecurity) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MailAccountIMAPSecurity_index)-1 {
+		return "MailAccountIMAPSecurity(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _MailAccountIMAPSecurity_name[_MailAccountIMAPSecurity_index[idx]:_MailAccountIMAPSecurity_index[idx+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MailAccountTypeUnspecified-0]
+	_ = x[MailAccountTypeIMAP-1]
+	_ = x[MailAccountTypeGmailOAuth-2]
+	_ = x[MailAccountTypeOutlookOAuth-3]
+}
+
+const _MailAccountType_name = "MailAccountTypeUnspecifiedMailAccountTypeIMAPMailAccountTypeGmailOAuthMailAccountTypeOutlookOAuth"
+
+var _MailAccountType_index = [...]uint8{0, 26, 45, 70, 97}
+
+func (i MailAccountType) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MailAccountType_index)-1 {
+		return "MailAccountType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _MailAccountType_name[_MailAccountType_index[idx]:_MailAccountType_index[idx+1]]
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestGetMailAccount(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodGet, "/api/mail_accounts/4/",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 4,
+			"name": "Scanner",
+			"imap_server": "imap.example.com",
+			"imap_port": 993,
+			"imap_security": 2,
+			"username": "scanner",
+			"password": "**********",
+			"character_set": "UTF-8",
+			"is_token": false,
+			"account_type": 1,
+			"expiration": null,
+			"owner": 1
+		}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.GetMailAccount(context.Background(), 4)
+	if err != nil {
+		t.Fatalf("GetMailAccount() failed: %v", err)
+	}
+
+	want := &MailAccount{
+		ID:           4,
+		Name:         "Scanner",
+		ImapServer:   "imap.example.com",
+		ImapPort:     Int64(993),
+		ImapSecurity: MailAccountIMAPSecuritySSL,
+		Username:     "scanner",
+		Password:     "**********",
+		CharacterSet: "UTF-8",
+		AccountType:  MailAccountTypeIMAP,
+		Owner:        Int64(1),
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("GetMailAccount() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestTestMailAccount(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		id       *int64
+		data     *MailAccountFields
+		wantBody map[string]any
+		status   int
+		response string
+		wantErr  error
+	}{
+		{
+			name: "success",
+			data: NewMailAccountFields().
+				SetImapServer("imap.example.com").
+				SetImapSecurity(MailAccountIMAPSecurityStartTLS).
+				SetUsername("user").
+				SetPassword("secret"),
+			wantBody: map[string]any{
+				"imap_server":   "imap.example.com",
+				"imap_security": 3,
+				"username":      "user",
+				"password":      "secret",
+			},
+			status:   http.StatusOK,
+			response: `{"success": true}`,
+		},
+		{
+			name: "existing account",
+			id:   Int64(12),
+			data: NewMailAccountFields().SetUsername("other"),
+			wantBody: map[string]any{
+				"id":       12,
+				"username": "other",
+			},
+			status:   http.StatusOK,
+			response: `{"success": false}`,
+			wantErr:  cmpopts.AnyError,
+		},
+		{
+			name:     "connection failure",
+			data:     NewMailAccountFields(),
+			wantBody: map[string]any{},
+			status:   http.StatusBadRequest,
+			response: `"Unable to connect to server"`,
+			wantErr: &RequestError{
+				StatusCode: http.StatusBadRequest,
+				Message:    `"Unable to connect to server"`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+			transport.RegisterResponder(http.MethodPost, "/api/mail_accounts/test/",
+				newJSONBodyResponder(t, tc.wantBody,
+					httpmock.NewStringResponder(tc.status, tc.response)))
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			_, err := c.TestMailAccount(context.Background(), tc.id, tc.data)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("TestMailAccount() error diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProcessMailAccount(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodPost, "/api/mail_accounts/3/process/",
+		httpmock.NewStringResponder(http.StatusOK, `{"result": "OK"}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	if _, err := c.ProcessMailAccount(context.Background(), 3); err != nil {
+		t.Errorf("ProcessMailAccount() failed: %v", err)
+	}
+}
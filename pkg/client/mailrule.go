@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=MailRuleAction,MailRuleAttachmentType,MailRuleConsumptionScope,MailRuleTitleSource,MailRuleCorrespondentSource -output=mailrule_string.go
+type MailRuleAction int
+
+const (
+	MailRuleActionUnspecified MailRuleAction = iota
+
+	// Delete processed messages.
+	MailRuleActionDelete
+
+	// Move processed messages to the folder given as the action parameter.
+	MailRuleActionMove
+
+	// Mark processed messages as read.
+	MailRuleActionMarkRead
+
+	// Flag processed messages.
+	MailRuleActionFlag
+
+	// Tag processed messages with the keyword given as the action parameter.
+	MailRuleActionTag
+)
+
+type MailRuleAttachmentType int
+
+const (
+	MailRuleAttachmentTypeUnspecified MailRuleAttachmentType = iota
+
+	// Only process attachments.
+	MailRuleAttachmentsOnly
+
+	// Process all files, including inline attachments.
+	MailRuleAttachmentsEverything
+)
+
+type MailRuleConsumptionScope int
+
+const (
+	MailRuleConsumptionScopeUnspecified MailRuleConsumptionScope = iota
+
+	// Only consume attachments.
+	MailRuleConsumeAttachmentsOnly
+
+	// Consume the message as a .eml file.
+	MailRuleConsumeEMLOnly
+
+	// Consume the message as a .eml file and its attachments as separate
+	// documents.
+	MailRuleConsumeEverything
+)
+
+type MailRuleTitleSource int
+
+const (
+	MailRuleTitleSourceUnspecified MailRuleTitleSource = iota
+
+	// Use the message subject as the title.
+	MailRuleTitleFromSubject
+
+	// Use the attachment filename as the title.
+	MailRuleTitleFromFilename
+
+	// Don't assign a title.
+	MailRuleTitleFromNone
+)
+
+type MailRuleCorrespondentSource int
+
+const (
+	MailRuleCorrespondentSourceUnspecified MailRuleCorrespondentSource = iota
+
+	// Don't assign a correspondent.
+	MailRuleCorrespondentFromNothing
+
+	// Use the sender's email address.
+	MailRuleCorrespondentFromEmail
+
+	// Use the sender's name, falling back to the email address.
+	MailRuleCorrespondentFromName
+
+	// Use the correspondent configured on the rule.
+	MailRuleCorrespondentFromCustom
+)
+
+func (c *Client) mailRuleCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/mail_rules/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(MailRule).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListMailRulesOptions).Page = page
+		},
+	}
+}
+
+type ListMailRulesOptions struct {
+	ListOptions
+}
+
+func (c *Client) ListMailRules(ctx context.Context, opts ListMailRulesOptions) ([]MailRule, *Response, error) {
+	ctx = withOperation(ctx, "ListMailRules")
+
+	return crudList[MailRule](ctx, c.mailRuleCrudOpts(), opts)
+}
+
+// ListAllMailRules iterates over all mail rules matching the filters specified
+// in opts, invoking handler for each.
+func (c *Client) ListAllMailRules(ctx context.Context, opts ListMailRulesOptions, handler func(context.Context, MailRule) error) error {
+	ctx = withOperation(ctx, "ListAllMailRules")
+
+	return crudListAll[MailRule](ctx, c.mailRuleCrudOpts(), opts, handler)
+}
+
+// IterAllMailRules returns an iterator over all mail rules matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllMailRules(ctx context.Context, opts ListMailRulesOptions) iter.Seq2[MailRule, error] {
+	ctx = withOperation(ctx, "IterAllMailRules")
+
+	return crudIterAll[MailRule](ctx, c.mailRuleCrudOpts(), opts)
+}
+
+func (c *Client) GetMailRule(ctx context.Context, id int64) (*MailRule, *Response, error) {
+	ctx = withOperation(ctx, "GetMailRule")
+
+	return crudGet[MailRule](ctx, c.mailRuleCrudOpts(), id)
+}
+
+func (c *Client) CreateMailRule(ctx context.Context, data *MailRuleFields) (*MailRule, *Response, error) {
+	ctx = withOperation(ctx, "CreateMailRule")
+
+	return crudCreate[MailRule](ctx, c.mailRuleCrudOpts(), data)
+}
+
+func (c *Client) UpdateMailRule(ctx context.Context, id int64, data *MailRule) (*MailRule, *Response, error) {
+	ctx = withOperation(ctx, "UpdateMailRule")
+
+	return crudUpdate[MailRule](ctx, c.mailRuleCrudOpts(), id, data)
+}
+
+func (c *Client) PatchMailRule(ctx context.Context, id int64, data *MailRuleFields) (*MailRule, *Response, error) {
+	ctx = withOperation(ctx, "PatchMailRule")
+
+	return crudPatch[MailRule](ctx, c.mailRuleCrudOpts(), id, data)
+}
+
+func (c *Client) DeleteMailRule(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteMailRule")
+
+	return crudDelete[MailRule](ctx, c.mailRuleCrudOpts(), id)
+}
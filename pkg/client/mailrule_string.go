@@ -0,0 +1,113 @@
+// Code generated by "stringer -type=MailRuleAction,MailRuleAttachmentType,MailRuleConsumptionScope,MailRuleTitleSource,MailRuleCorrespondentSource -output=mailrule_string.go"; DO NOT EDIT.
+
+package client
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MailRuleActionUnspecified-0]
+	_ = x[MailRuleActionDelete-1]
+	_ = x[MailRuleActionMove-2]
+	_ = x[MailRuleActionMarkRead-3]
+	_ = x[MailRuleActionFlag-4]
+	_ = x[MailRuleActionTag-5]
+}
+
+const _MailRuleAction_name = "MailRuleActionUnspecifiedMailRuleActionDeleteMailRuleActionMoveMailRuleActionMarkReadMailRuleActionFlagMailRuleActionTag"
+
+var _MailRuleAction_index = [...]uint8{0, 25, 45, 63, 85, 103, 120}
+
+func (i MailRuleAction) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MailRuleAction_index)-1 {
+		return "MailRuleAction(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _MailRuleAction_name[_MailRuleAction_index[idx]:_MailRuleAction_index[idx+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MailRuleAttachmentTypeUnspecified-0]
+	_ = x[MailRuleAttachmentsOnly-1]
+	_ = x[MailRuleAttachmentsEverything-2]
+}
+
+const _MailRuleAttachmentType_name = "MailRuleAttachmentTypeUnspecifiedMailRuleAttachmentsOnlyMailRuleAttachmentsEverything"
+
+var _MailRuleAttachmentType_index = [...]uint8{0, 33, 56, 85}
+
+func (i MailRuleAttachmentType) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MailRuleAttachmentType_index)-1 {
+		return "MailRuleAttachmentType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _MailRuleAttachmentType_name[_MailRuleAttachmentType_index[idx]:_MailRuleAttachmentType_index[idx+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MailRuleConsumptionScopeUnspecified-0]
+	_ = x[MailRuleConsumeAttachmentsOnly-1]
+	_ = x[MailRuleConsumeEMLOnly-2]
+	_ = x[MailRuleConsumeEverything-3]
+}
+
+const _MailRuleConsumptionScope_name = "MailRuleConsumptionScopeUnspecifiedMailRuleConsumeAttachmentsOnlyMailRuleConsumeEMLOnlyMailRuleConsumeEverything"
+
+var _MailRuleConsumptionScope_index = [...]uint8{0, 35, 65, 87, 112}
+
+func (i MailRuleConsumptionScope) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MailRuleConsumptionScope_index)-1 {
+		return "MailRuleConsumptionScope(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _MailRuleConsumptionScope_name[_MailRuleConsumptionScope_index[idx]:_MailRuleConsumptionScope_index[idx+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MailRuleTitleSourceUnspecified-0]
+	_ = x[MailRuleTitleFromSubject-1]
+	_ = x[MailRuleTitleFromFilename-2]
+	_ = x[MailRuleTitleFromNone-3]
+}
+
+const _MailRuleTitleSource_name = "MailRuleTitleSourceUnspecifiedMailRuleTitleFromSubjectMailRuleTitleFromFilenameMailRuleTitleFromNone"
+
+var _MailRuleTitleSource_index = [...]uint8{0, 30, 54, 79, 100}
+
+func (i MailRuleTitleSource) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MailRuleTitleSource_index)-1 {
+		return "MailRuleTitleSource(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _MailRuleTitleSource_name[_MailRuleTitleSource_index[idx]:_MailRuleTitleSource_index[idx+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[MailRuleCorrespondentSourceUnspecified-0]
+	_ = x[MailRuleCorrespondentFromNothing-1]
+	_ = x[MailRuleCorrespondentFromEmail-2]
+	_ = x[MailRuleCorrespondentFromName-3]
+	_ = x[MailRuleCorrespondentFromCustom-4]
+}
+
+const _MailRuleCorrespondentSource_name = "MailRuleCorrespondentSourceUnspecifiedMailRuleCorrespondentFromNothingMailRuleCorrespondentFromEmailMailRuleCorrespondentFromNameMailRuleCorrespondentFromCustom"
+
+var _MailRuleCorrespondentSource_index = [...]uint8{0, 38, 70, 100, 129, 160}
+
+func (i MailRuleCorrespondentSource) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_MailRuleCorrespondentSource_index)-1 {
+		return "MailRuleCorrespondentSource(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _MailRuleCorrespondentSource_name[_MailRuleCorrespondentSource_index[idx]:_MailRuleCorrespondentSource_index[idx+1]]
+}
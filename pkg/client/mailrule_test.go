@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestListAllMailRules(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/mail_rules/",
+		"page=1&page_size=25",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"next": "?page=2",
+			"results": [
+				{
+					"id": 1,
+					"name": "Invoices",
+					"account": 4,
+					"enabled": true,
+					"folder": "INBOX",
+					"filter_subject": "Invoice",
+					"maximum_age": 30,
+					"action": 2,
+					"action_parameter": "Processed",
+					"assign_title_from": 1,
+					"assign_tags": [7],
+					"assign_correspondent_from": 4,
+					"assign_correspondent": 9,
+					"order": 0,
+					"attachment_type": 1,
+					"consumption_scope": 3
+				}
+			]
+		}`))
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/mail_rules/",
+		"page=2&page_size=25",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"results": [
+				{ "id": 2, "name": "Other", "action": 3 }
+			]
+		}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	var got []MailRule
+
+	if err := c.ListAllMailRules(context.Background(), ListMailRulesOptions{}, func(_ context.Context, r MailRule) error {
+		got = append(got, r)
+		return nil
+	}); err != nil {
+		t.Fatalf("ListAllMailRules() failed: %v", err)
+	}
+
+	want := []MailRule{
+		{
+			ID:                      1,
+			Name:                    "Invoices",
+			Account:                 4,
+			Enabled:                 true,
+			Folder:                  "INBOX",
+			FilterSubject:           String("Invoice"),
+			MaximumAge:              30,
+			Action:                  MailRuleActionMove,
+			ActionParameter:         String("Processed"),
+			AssignTitleFrom:         MailRuleTitleFromSubject,
+			AssignTags:              []int64{7},
+			AssignCorrespondentFrom: MailRuleCorrespondentFromCustom,
+			AssignCorrespondent:     Int64(9),
+			AttachmentType:          MailRuleAttachmentsOnly,
+			ConsumptionScope:        MailRuleConsumeEverything,
+		},
+		{
+			ID:     2,
+			Name:   "Other",
+			Action: MailRuleActionMarkRead,
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("ListAllMailRules() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestCreateMailRule(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodPost, "/api/mail_rules/",
+		newJSONBodyResponder(t, map[string]any{
+			"name":              "Receipts",
+			"account":           2,
+			"action":            5,
+			"action_parameter":  "paperless",
+			"consumption_scope": 1,
+		}, httpmock.NewStringResponder(http.StatusCreated, `{
+			"id": 31,
+			"name": "Receipts"
+		}`)))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.CreateMailRule(context.Background(), NewMailRuleFields().
+		SetName("Receipts").
+		SetAccount(2).
+		SetAction(MailRuleActionTag).
+		SetActionParameter(String("paperless")).
+		SetConsumptionScope(MailRuleConsumeAttachmentsOnly))
+	if err != nil {
+		t.Fatalf("CreateMailRule() failed: %v", err)
+	}
+
+	if diff := cmp.Diff(&MailRule{ID: 31, Name: "Receipts"}, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("CreateMailRule() diff (-want +got):\n%s", diff)
+	}
+
+	if got := MailRuleActionTag.String(); got != "MailRuleActionTag" {
+		t.Errorf("String() returned %q", got)
+	}
+}
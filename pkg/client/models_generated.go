@@ -70,9 +70,12 @@ func (f *CorrespondentFields) SetSetPermissions(setPermissions *ObjectPermission
 }
 
 type CustomField struct {
-	ID       int64  `json:"id"`
-	Name     string `json:"name"`
-	DataType string `json:"data_type"`
+	ID       int64               `json:"id"`
+	Name     string              `json:"name"`
+	DataType CustomFieldDataType `json:"data_type"`
+
+	// Additional settings depending on the data type.
+	ExtraData *CustomFieldExtraData `json:"extra_data"`
 
 	// Object owner; objects without owner can be viewed and edited by all users.
 	Owner *int64 `json:"owner"`
@@ -95,11 +98,19 @@ func (f *CustomFieldFields) SetName(name string) *CustomFieldFields {
 }
 
 // SetDataType sets the "data_type" field.
-func (f *CustomFieldFields) SetDataType(dataType string) *CustomFieldFields {
+func (f *CustomFieldFields) SetDataType(dataType CustomFieldDataType) *CustomFieldFields {
 	f.set("data_type", dataType)
 	return f
 }
 
+// SetExtraData sets the "extra_data" field.
+//
+// Additional settings depending on the data type.
+func (f *CustomFieldFields) SetExtraData(extraData *CustomFieldExtraData) *CustomFieldFields {
+	f.set("extra_data", extraData)
+	return f
+}
+
 // SetOwner sets the "owner" field.
 //
 // Object owner; objects without owner can be viewed and edited by all users.
@@ -159,6 +170,12 @@ type Document struct {
 	// Custom fields on the document.
 	CustomFields []CustomFieldInstance `json:"custom_fields"`
 
+	// The time at which the document was moved to the trash. Nil unless retrieved from the trash.
+	DeletedAt *time.Time `json:"deleted_at"`
+
+	// Details on the match of a full-text search. Nil if the document wasn't retrieved with a search query.
+	SearchHit *SearchHit `json:"__search_hit__"`
+
 	// Object owner; objects without owner can be viewed and edited by all users.
 	Owner *int64 `json:"owner"`
 }
@@ -345,6 +362,523 @@ func (f *GroupFields) SetName(name string) *GroupFields {
 	return f
 }
 
+type MailAccount struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	ImapServer string `json:"imap_server"`
+
+	// IMAP port or nil for the default port of the security mode.
+	ImapPort     *int64                  `json:"imap_port"`
+	ImapSecurity MailAccountIMAPSecurity `json:"imap_security"`
+	Username     string                  `json:"username"`
+
+	// Password or OAuth token. Masked when retrieved from the server.
+	Password string `json:"password"`
+
+	// Character set for communicating with the server, e.g. "UTF-8".
+	CharacterSet string `json:"character_set"`
+
+	// Whether the password is an OAuth token.
+	IsToken     bool            `json:"is_token"`
+	AccountType MailAccountType `json:"account_type"`
+
+	// Expiration of the OAuth token, if any.
+	Expiration *time.Time `json:"expiration"`
+
+	// Object owner; objects without owner can be viewed and edited by all users.
+	Owner *int64 `json:"owner"`
+}
+
+type MailAccountFields struct {
+	objectFields
+}
+
+var _ json.Marshaler = (*MailAccountFields)(nil)
+
+func NewMailAccountFields() *MailAccountFields {
+	return &MailAccountFields{objectFields{}}
+}
+
+// SetName sets the "name" field.
+func (f *MailAccountFields) SetName(name string) *MailAccountFields {
+	f.set("name", name)
+	return f
+}
+
+// SetImapServer sets the "imap_server" field.
+func (f *MailAccountFields) SetImapServer(imapServer string) *MailAccountFields {
+	f.set("imap_server", imapServer)
+	return f
+}
+
+// SetImapPort sets the "imap_port" field.
+//
+// IMAP port or nil for the default port of the security mode.
+func (f *MailAccountFields) SetImapPort(imapPort *int64) *MailAccountFields {
+	f.set("imap_port", imapPort)
+	return f
+}
+
+// SetImapSecurity sets the "imap_security" field.
+func (f *MailAccountFields) SetImapSecurity(imapSecurity MailAccountIMAPSecurity) *MailAccountFields {
+	f.set("imap_security", imapSecurity)
+	return f
+}
+
+// SetUsername sets the "username" field.
+func (f *MailAccountFields) SetUsername(username string) *MailAccountFields {
+	f.set("username", username)
+	return f
+}
+
+// SetPassword sets the "password" field.
+//
+// Password or OAuth token. Masked when retrieved from the server.
+func (f *MailAccountFields) SetPassword(password string) *MailAccountFields {
+	f.set("password", password)
+	return f
+}
+
+// SetCharacterSet sets the "character_set" field.
+//
+// Character set for communicating with the server, e.g. "UTF-8".
+func (f *MailAccountFields) SetCharacterSet(characterSet string) *MailAccountFields {
+	f.set("character_set", characterSet)
+	return f
+}
+
+// SetIsToken sets the "is_token" field.
+//
+// Whether the password is an OAuth token.
+func (f *MailAccountFields) SetIsToken(isToken bool) *MailAccountFields {
+	f.set("is_token", isToken)
+	return f
+}
+
+// SetAccountType sets the "account_type" field.
+func (f *MailAccountFields) SetAccountType(accountType MailAccountType) *MailAccountFields {
+	f.set("account_type", accountType)
+	return f
+}
+
+// SetOwner sets the "owner" field.
+//
+// Object owner; objects without owner can be viewed and edited by all users.
+func (f *MailAccountFields) SetOwner(owner *int64) *MailAccountFields {
+	f.set("owner", owner)
+	return f
+}
+
+// SetSetPermissions sets the "set_permissions" field.
+//
+// Change object-level permissions.
+func (f *MailAccountFields) SetSetPermissions(setPermissions *ObjectPermissions) *MailAccountFields {
+	f.set("set_permissions", setPermissions)
+	return f
+}
+
+type MailRule struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// ID of the mail account.
+	Account int64 `json:"account"`
+	Enabled bool  `json:"enabled"`
+
+	// IMAP folder, e.g. "INBOX". Subfolders are separated by the server-specific delimiter.
+	Folder        string  `json:"folder"`
+	FilterFrom    *string `json:"filter_from"`
+	FilterTo      *string `json:"filter_to"`
+	FilterSubject *string `json:"filter_subject"`
+	FilterBody    *string `json:"filter_body"`
+
+	// Only consume attachments whose name matches this pattern (case-insensitive, wildcards allowed).
+	FilterAttachmentFilenameInclude *string `json:"filter_attachment_filename_include"`
+
+	// Do not consume attachments whose name matches this pattern (case-insensitive, wildcards allowed).
+	FilterAttachmentFilenameExclude *string `json:"filter_attachment_filename_exclude"`
+
+	// Maximum age of messages in days.
+	MaximumAge int64 `json:"maximum_age"`
+
+	// Action applied to messages after processing.
+	Action MailRuleAction `json:"action"`
+
+	// Parameter for the action, e.g. the destination folder for [MailRuleActionMove].
+	ActionParameter         *string                     `json:"action_parameter"`
+	AssignTitleFrom         MailRuleTitleSource         `json:"assign_title_from"`
+	AssignTags              []int64                     `json:"assign_tags"`
+	AssignCorrespondentFrom MailRuleCorrespondentSource `json:"assign_correspondent_from"`
+
+	// Correspondent for [MailRuleCorrespondentFromCustom].
+	AssignCorrespondent *int64 `json:"assign_correspondent"`
+	AssignDocumentType  *int64 `json:"assign_document_type"`
+
+	// Assign the owner of the rule to consumed documents.
+	AssignOwnerFromRule bool                     `json:"assign_owner_from_rule"`
+	Order               int64                    `json:"order"`
+	AttachmentType      MailRuleAttachmentType   `json:"attachment_type"`
+	ConsumptionScope    MailRuleConsumptionScope `json:"consumption_scope"`
+
+	// Object owner; objects without owner can be viewed and edited by all users.
+	Owner *int64 `json:"owner"`
+}
+
+type MailRuleFields struct {
+	objectFields
+}
+
+var _ json.Marshaler = (*MailRuleFields)(nil)
+
+func NewMailRuleFields() *MailRuleFields {
+	return &MailRuleFields{objectFields{}}
+}
+
+// SetName sets the "name" field.
+func (f *MailRuleFields) SetName(name string) *MailRuleFields {
+	f.set("name", name)
+	return f
+}
+
+// SetAccount sets the "account" field.
+//
+// ID of the mail account.
+func (f *MailRuleFields) SetAccount(account int64) *MailRuleFields {
+	f.set("account", account)
+	return f
+}
+
+// SetEnabled sets the "enabled" field.
+func (f *MailRuleFields) SetEnabled(enabled bool) *MailRuleFields {
+	f.set("enabled", enabled)
+	return f
+}
+
+// SetFolder sets the "folder" field.
+//
+// IMAP folder, e.g. "INBOX". Subfolders are separated by the server-specific delimiter.
+func (f *MailRuleFields) SetFolder(folder string) *MailRuleFields {
+	f.set("folder", folder)
+	return f
+}
+
+// SetFilterFrom sets the "filter_from" field.
+func (f *MailRuleFields) SetFilterFrom(filterFrom *string) *MailRuleFields {
+	f.set("filter_from", filterFrom)
+	return f
+}
+
+// SetFilterTo sets the "filter_to" field.
+func (f *MailRuleFields) SetFilterTo(filterTo *string) *MailRuleFields {
+	f.set("filter_to", filterTo)
+	return f
+}
+
+// SetFilterSubject sets the "filter_subject" field.
+func (f *MailRuleFields) SetFilterSubject(filterSubject *string) *MailRuleFields {
+	f.set("filter_subject", filterSubject)
+	return f
+}
+
+// SetFilterBody sets the "filter_body" field.
+func (f *MailRuleFields) SetFilterBody(filterBody *string) *MailRuleFields {
+	f.set("filter_body", filterBody)
+	return f
+}
+
+// SetFilterAttachmentFilenameInclude sets the "filter_attachment_filename_include" field.
+//
+// Only consume attachments whose name matches this pattern (case-insensitive, wildcards allowed).
+func (f *MailRuleFields) SetFilterAttachmentFilenameInclude(filterAttachmentFilenameInclude *string) *MailRuleFields {
+	f.set("filter_attachment_filename_include", filterAttachmentFilenameInclude)
+	return f
+}
+
+// SetFilterAttachmentFilenameExclude sets the "filter_attachment_filename_exclude" field.
+//
+// Do not consume attachments whose name matches this pattern (case-insensitive, wildcards allowed).
+func (f *MailRuleFields) SetFilterAttachmentFilenameExclude(filterAttachmentFilenameExclude *string) *MailRuleFields {
+	f.set("filter_attachment_filename_exclude", filterAttachmentFilenameExclude)
+	return f
+}
+
+// SetMaximumAge sets the "maximum_age" field.
+//
+// Maximum age of messages in days.
+func (f *MailRuleFields) SetMaximumAge(maximumAge int64) *MailRuleFields {
+	f.set("maximum_age", maximumAge)
+	return f
+}
+
+// SetAction sets the "action" field.
+//
+// Action applied to messages after processing.
+func (f *MailRuleFields) SetAction(action MailRuleAction) *MailRuleFields {
+	f.set("action", action)
+	return f
+}
+
+// SetActionParameter sets the "action_parameter" field.
+//
+// Parameter for the action, e.g. the destination folder for [MailRuleActionMove].
+func (f *MailRuleFields) SetActionParameter(actionParameter *string) *MailRuleFields {
+	f.set("action_parameter", actionParameter)
+	return f
+}
+
+// SetAssignTitleFrom sets the "assign_title_from" field.
+func (f *MailRuleFields) SetAssignTitleFrom(assignTitleFrom MailRuleTitleSource) *MailRuleFields {
+	f.set("assign_title_from", assignTitleFrom)
+	return f
+}
+
+// SetAssignTags sets the "assign_tags" field.
+func (f *MailRuleFields) SetAssignTags(assignTags []int64) *MailRuleFields {
+	f.set("assign_tags", assignTags)
+	return f
+}
+
+// SetAssignCorrespondentFrom sets the "assign_correspondent_from" field.
+func (f *MailRuleFields) SetAssignCorrespondentFrom(assignCorrespondentFrom MailRuleCorrespondentSource) *MailRuleFields {
+	f.set("assign_correspondent_from", assignCorrespondentFrom)
+	return f
+}
+
+// SetAssignCorrespondent sets the "assign_correspondent" field.
+//
+// Correspondent for [MailRuleCorrespondentFromCustom].
+func (f *MailRuleFields) SetAssignCorrespondent(assignCorrespondent *int64) *MailRuleFields {
+	f.set("assign_correspondent", assignCorrespondent)
+	return f
+}
+
+// SetAssignDocumentType sets the "assign_document_type" field.
+func (f *MailRuleFields) SetAssignDocumentType(assignDocumentType *int64) *MailRuleFields {
+	f.set("assign_document_type", assignDocumentType)
+	return f
+}
+
+// SetAssignOwnerFromRule sets the "assign_owner_from_rule" field.
+//
+// Assign the owner of the rule to consumed documents.
+func (f *MailRuleFields) SetAssignOwnerFromRule(assignOwnerFromRule bool) *MailRuleFields {
+	f.set("assign_owner_from_rule", assignOwnerFromRule)
+	return f
+}
+
+// SetOrder sets the "order" field.
+func (f *MailRuleFields) SetOrder(order int64) *MailRuleFields {
+	f.set("order", order)
+	return f
+}
+
+// SetAttachmentType sets the "attachment_type" field.
+func (f *MailRuleFields) SetAttachmentType(attachmentType MailRuleAttachmentType) *MailRuleFields {
+	f.set("attachment_type", attachmentType)
+	return f
+}
+
+// SetConsumptionScope sets the "consumption_scope" field.
+func (f *MailRuleFields) SetConsumptionScope(consumptionScope MailRuleConsumptionScope) *MailRuleFields {
+	f.set("consumption_scope", consumptionScope)
+	return f
+}
+
+// SetOwner sets the "owner" field.
+//
+// Object owner; objects without owner can be viewed and edited by all users.
+func (f *MailRuleFields) SetOwner(owner *int64) *MailRuleFields {
+	f.set("owner", owner)
+	return f
+}
+
+// SetSetPermissions sets the "set_permissions" field.
+//
+// Change object-level permissions.
+func (f *MailRuleFields) SetSetPermissions(setPermissions *ObjectPermissions) *MailRuleFields {
+	f.set("set_permissions", setPermissions)
+	return f
+}
+
+type SavedView struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// Show the view on the dashboard.
+	ShowOnDashboard bool `json:"show_on_dashboard"`
+
+	// Show the view in the navigation sidebar.
+	ShowInSidebar bool `json:"show_in_sidebar"`
+
+	// Field by which documents are sorted or nil for the default order.
+	SortField *string `json:"sort_field"`
+
+	// Sort documents in descending order.
+	SortReverse bool `json:"sort_reverse"`
+
+	// Rules selecting the documents shown by the view.
+	FilterRules []FilterRule `json:"filter_rules"`
+
+	// Number of documents per page or nil for the default.
+	PageSize *int64 `json:"page_size"`
+
+	// Display mode, e.g. "table" or "smallCards".
+	DisplayMode *string `json:"display_mode"`
+
+	// Fields to display, e.g. "title" or "tag".
+	DisplayFields []string `json:"display_fields"`
+
+	// Object owner; objects without owner can be viewed and edited by all users.
+	Owner *int64 `json:"owner"`
+}
+
+type SavedViewFields struct {
+	objectFields
+}
+
+var _ json.Marshaler = (*SavedViewFields)(nil)
+
+func NewSavedViewFields() *SavedViewFields {
+	return &SavedViewFields{objectFields{}}
+}
+
+// SetName sets the "name" field.
+func (f *SavedViewFields) SetName(name string) *SavedViewFields {
+	f.set("name", name)
+	return f
+}
+
+// SetShowOnDashboard sets the "show_on_dashboard" field.
+//
+// Show the view on the dashboard.
+func (f *SavedViewFields) SetShowOnDashboard(showOnDashboard bool) *SavedViewFields {
+	f.set("show_on_dashboard", showOnDashboard)
+	return f
+}
+
+// SetShowInSidebar sets the "show_in_sidebar" field.
+//
+// Show the view in the navigation sidebar.
+func (f *SavedViewFields) SetShowInSidebar(showInSidebar bool) *SavedViewFields {
+	f.set("show_in_sidebar", showInSidebar)
+	return f
+}
+
+// SetSortField sets the "sort_field" field.
+//
+// Field by which documents are sorted or nil for the default order.
+func (f *SavedViewFields) SetSortField(sortField *string) *SavedViewFields {
+	f.set("sort_field", sortField)
+	return f
+}
+
+// SetSortReverse sets the "sort_reverse" field.
+//
+// Sort documents in descending order.
+func (f *SavedViewFields) SetSortReverse(sortReverse bool) *SavedViewFields {
+	f.set("sort_reverse", sortReverse)
+	return f
+}
+
+// SetFilterRules sets the "filter_rules" field.
+//
+// Rules selecting the documents shown by the view.
+func (f *SavedViewFields) SetFilterRules(filterRules []FilterRule) *SavedViewFields {
+	f.set("filter_rules", filterRules)
+	return f
+}
+
+// SetPageSize sets the "page_size" field.
+//
+// Number of documents per page or nil for the default.
+func (f *SavedViewFields) SetPageSize(pageSize *int64) *SavedViewFields {
+	f.set("page_size", pageSize)
+	return f
+}
+
+// SetDisplayMode sets the "display_mode" field.
+//
+// Display mode, e.g. "table" or "smallCards".
+func (f *SavedViewFields) SetDisplayMode(displayMode *string) *SavedViewFields {
+	f.set("display_mode", displayMode)
+	return f
+}
+
+// SetDisplayFields sets the "display_fields" field.
+//
+// Fields to display, e.g. "title" or "tag".
+func (f *SavedViewFields) SetDisplayFields(displayFields []string) *SavedViewFields {
+	f.set("display_fields", displayFields)
+	return f
+}
+
+// SetOwner sets the "owner" field.
+//
+// Object owner; objects without owner can be viewed and edited by all users.
+func (f *SavedViewFields) SetOwner(owner *int64) *SavedViewFields {
+	f.set("owner", owner)
+	return f
+}
+
+// SetSetPermissions sets the "set_permissions" field.
+//
+// Change object-level permissions.
+func (f *SavedViewFields) SetSetPermissions(setPermissions *ObjectPermissions) *SavedViewFields {
+	f.set("set_permissions", setPermissions)
+	return f
+}
+
+type ShareLink struct {
+	ID      int64     `json:"id"`
+	Created time.Time `json:"created"`
+
+	// Time after which the link can no longer be used. Nil if the link doesn't expire.
+	Expiration *time.Time `json:"expiration"`
+
+	// Random identifier used in the public URL.
+	Slug string `json:"slug"`
+
+	// ID of the shared document.
+	Document int64 `json:"document"`
+
+	// Version of the document file made available.
+	FileVersion ShareLinkFileVersion `json:"file_version"`
+}
+
+type ShareLinkFields struct {
+	objectFields
+}
+
+var _ json.Marshaler = (*ShareLinkFields)(nil)
+
+func NewShareLinkFields() *ShareLinkFields {
+	return &ShareLinkFields{objectFields{}}
+}
+
+// SetExpiration sets the "expiration" field.
+//
+// Time after which the link can no longer be used. Nil if the link doesn't expire.
+func (f *ShareLinkFields) SetExpiration(expiration *time.Time) *ShareLinkFields {
+	f.set("expiration", expiration)
+	return f
+}
+
+// SetDocument sets the "document" field.
+//
+// ID of the shared document.
+func (f *ShareLinkFields) SetDocument(document int64) *ShareLinkFields {
+	f.set("document", document)
+	return f
+}
+
+// SetFileVersion sets the "file_version" field.
+//
+// Version of the document file made available.
+func (f *ShareLinkFields) SetFileVersion(fileVersion ShareLinkFileVersion) *ShareLinkFields {
+	f.set("file_version", fileVersion)
+	return f
+}
+
 type StoragePath struct {
 	ID                int64             `json:"id"`
 	Slug              string            `json:"slug"`
@@ -554,3 +1088,455 @@ func (f *UserFields) SetIsSuperuser(isSuperuser bool) *UserFields {
 	f.set("is_superuser", isSuperuser)
 	return f
 }
+
+type Workflow struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Order   int64  `json:"order"`
+	Enabled bool   `json:"enabled"`
+
+	// Triggers starting the workflow. Triggers without ID are created.
+	Triggers []WorkflowTrigger `json:"triggers"`
+
+	// Actions executed in order. Actions without ID are created.
+	Actions []WorkflowAction `json:"actions"`
+}
+
+type WorkflowFields struct {
+	objectFields
+}
+
+var _ json.Marshaler = (*WorkflowFields)(nil)
+
+func NewWorkflowFields() *WorkflowFields {
+	return &WorkflowFields{objectFields{}}
+}
+
+// SetName sets the "name" field.
+func (f *WorkflowFields) SetName(name string) *WorkflowFields {
+	f.set("name", name)
+	return f
+}
+
+// SetOrder sets the "order" field.
+func (f *WorkflowFields) SetOrder(order int64) *WorkflowFields {
+	f.set("order", order)
+	return f
+}
+
+// SetEnabled sets the "enabled" field.
+func (f *WorkflowFields) SetEnabled(enabled bool) *WorkflowFields {
+	f.set("enabled", enabled)
+	return f
+}
+
+// SetTriggers sets the "triggers" field.
+//
+// Triggers starting the workflow. Triggers without ID are created.
+func (f *WorkflowFields) SetTriggers(triggers []WorkflowTrigger) *WorkflowFields {
+	f.set("triggers", triggers)
+	return f
+}
+
+// SetActions sets the "actions" field.
+//
+// Actions executed in order. Actions without ID are created.
+func (f *WorkflowFields) SetActions(actions []WorkflowAction) *WorkflowFields {
+	f.set("actions", actions)
+	return f
+}
+
+type WorkflowAction struct {
+	// ID of the action. Zero for new actions.
+	ID   int64              `json:"id,omitempty"`
+	Type WorkflowActionType `json:"type"`
+
+	// Title to assign; may contain placeholders.
+	AssignTitle             *string `json:"assign_title"`
+	AssignTags              []int64 `json:"assign_tags"`
+	AssignCorrespondent     *int64  `json:"assign_correspondent"`
+	AssignDocumentType      *int64  `json:"assign_document_type"`
+	AssignStoragePath       *int64  `json:"assign_storage_path"`
+	AssignOwner             *int64  `json:"assign_owner"`
+	AssignViewUsers         []int64 `json:"assign_view_users"`
+	AssignViewGroups        []int64 `json:"assign_view_groups"`
+	AssignChangeUsers       []int64 `json:"assign_change_users"`
+	AssignChangeGroups      []int64 `json:"assign_change_groups"`
+	AssignCustomFields      []int64 `json:"assign_custom_fields"`
+	RemoveTags              []int64 `json:"remove_tags"`
+	RemoveAllTags           bool    `json:"remove_all_tags"`
+	RemoveCorrespondents    []int64 `json:"remove_correspondents"`
+	RemoveAllCorrespondents bool    `json:"remove_all_correspondents"`
+	RemoveDocumentTypes     []int64 `json:"remove_document_types"`
+	RemoveAllDocumentTypes  bool    `json:"remove_all_document_types"`
+	RemoveStoragePaths      []int64 `json:"remove_storage_paths"`
+	RemoveAllStoragePaths   bool    `json:"remove_all_storage_paths"`
+	RemoveCustomFields      []int64 `json:"remove_custom_fields"`
+	RemoveAllCustomFields   bool    `json:"remove_all_custom_fields"`
+	RemoveOwners            []int64 `json:"remove_owners"`
+	RemoveAllOwners         bool    `json:"remove_all_owners"`
+	RemoveViewUsers         []int64 `json:"remove_view_users"`
+	RemoveViewGroups        []int64 `json:"remove_view_groups"`
+	RemoveChangeUsers       []int64 `json:"remove_change_users"`
+	RemoveChangeGroups      []int64 `json:"remove_change_groups"`
+	RemoveAllPermissions    bool    `json:"remove_all_permissions"`
+
+	// Settings for email actions.
+	Email *WorkflowActionEmail `json:"email"`
+
+	// Settings for webhook actions.
+	Webhook *WorkflowActionWebhook `json:"webhook"`
+}
+
+type WorkflowActionFields struct {
+	objectFields
+}
+
+var _ json.Marshaler = (*WorkflowActionFields)(nil)
+
+func NewWorkflowActionFields() *WorkflowActionFields {
+	return &WorkflowActionFields{objectFields{}}
+}
+
+// SetType sets the "type" field.
+func (f *WorkflowActionFields) SetType(typeValue WorkflowActionType) *WorkflowActionFields {
+	f.set("type", typeValue)
+	return f
+}
+
+// SetAssignTitle sets the "assign_title" field.
+//
+// Title to assign; may contain placeholders.
+func (f *WorkflowActionFields) SetAssignTitle(assignTitle *string) *WorkflowActionFields {
+	f.set("assign_title", assignTitle)
+	return f
+}
+
+// SetAssignTags sets the "assign_tags" field.
+func (f *WorkflowActionFields) SetAssignTags(assignTags []int64) *WorkflowActionFields {
+	f.set("assign_tags", assignTags)
+	return f
+}
+
+// SetAssignCorrespondent sets the "assign_correspondent" field.
+func (f *WorkflowActionFields) SetAssignCorrespondent(assignCorrespondent *int64) *WorkflowActionFields {
+	f.set("assign_correspondent", assignCorrespondent)
+	return f
+}
+
+// SetAssignDocumentType sets the "assign_document_type" field.
+func (f *WorkflowActionFields) SetAssignDocumentType(assignDocumentType *int64) *WorkflowActionFields {
+	f.set("assign_document_type", assignDocumentType)
+	return f
+}
+
+// SetAssignStoragePath sets the "assign_storage_path" field.
+func (f *WorkflowActionFields) SetAssignStoragePath(assignStoragePath *int64) *WorkflowActionFields {
+	f.set("assign_storage_path", assignStoragePath)
+	return f
+}
+
+// SetAssignOwner sets the "assign_owner" field.
+func (f *WorkflowActionFields) SetAssignOwner(assignOwner *int64) *WorkflowActionFields {
+	f.set("assign_owner", assignOwner)
+	return f
+}
+
+// SetAssignViewUsers sets the "assign_view_users" field.
+func (f *WorkflowActionFields) SetAssignViewUsers(assignViewUsers []int64) *WorkflowActionFields {
+	f.set("assign_view_users", assignViewUsers)
+	return f
+}
+
+// SetAssignViewGroups sets the "assign_view_groups" field.
+func (f *WorkflowActionFields) SetAssignViewGroups(assignViewGroups []int64) *WorkflowActionFields {
+	f.set("assign_view_groups", assignViewGroups)
+	return f
+}
+
+// SetAssignChangeUsers sets the "assign_change_users" field.
+func (f *WorkflowActionFields) SetAssignChangeUsers(assignChangeUsers []int64) *WorkflowActionFields {
+	f.set("assign_change_users", assignChangeUsers)
+	return f
+}
+
+// SetAssignChangeGroups sets the "assign_change_groups" field.
+func (f *WorkflowActionFields) SetAssignChangeGroups(assignChangeGroups []int64) *WorkflowActionFields {
+	f.set("assign_change_groups", assignChangeGroups)
+	return f
+}
+
+// SetAssignCustomFields sets the "assign_custom_fields" field.
+func (f *WorkflowActionFields) SetAssignCustomFields(assignCustomFields []int64) *WorkflowActionFields {
+	f.set("assign_custom_fields", assignCustomFields)
+	return f
+}
+
+// SetRemoveTags sets the "remove_tags" field.
+func (f *WorkflowActionFields) SetRemoveTags(removeTags []int64) *WorkflowActionFields {
+	f.set("remove_tags", removeTags)
+	return f
+}
+
+// SetRemoveAllTags sets the "remove_all_tags" field.
+func (f *WorkflowActionFields) SetRemoveAllTags(removeAllTags bool) *WorkflowActionFields {
+	f.set("remove_all_tags", removeAllTags)
+	return f
+}
+
+// SetRemoveCorrespondents sets the "remove_correspondents" field.
+func (f *WorkflowActionFields) SetRemoveCorrespondents(removeCorrespondents []int64) *WorkflowActionFields {
+	f.set("remove_correspondents", removeCorrespondents)
+	return f
+}
+
+// SetRemoveAllCorrespondents sets the "remove_all_correspondents" field.
+func (f *WorkflowActionFields) SetRemoveAllCorrespondents(removeAllCorrespondents bool) *WorkflowActionFields {
+	f.set("remove_all_correspondents", removeAllCorrespondents)
+	return f
+}
+
+// SetRemoveDocumentTypes sets the "remove_document_types" field.
+func (f *WorkflowActionFields) SetRemoveDocumentTypes(removeDocumentTypes []int64) *WorkflowActionFields {
+	f.set("remove_document_types", removeDocumentTypes)
+	return f
+}
+
+// SetRemoveAllDocumentTypes sets the "remove_all_document_types" field.
+func (f *WorkflowActionFields) SetRemoveAllDocumentTypes(removeAllDocumentTypes bool) *WorkflowActionFields {
+	f.set("remove_all_document_types", removeAllDocumentTypes)
+	return f
+}
+
+// SetRemoveStoragePaths sets the "remove_storage_paths" field.
+func (f *WorkflowActionFields) SetRemoveStoragePaths(removeStoragePaths []int64) *WorkflowActionFields {
+	f.set("remove_storage_paths", removeStoragePaths)
+	return f
+}
+
+// SetRemoveAllStoragePaths sets the "remove_all_storage_paths" field.
+func (f *WorkflowActionFields) SetRemoveAllStoragePaths(removeAllStoragePaths bool) *WorkflowActionFields {
+	f.set("remove_all_storage_paths", removeAllStoragePaths)
+	return f
+}
+
+// SetRemoveCustomFields sets the "remove_custom_fields" field.
+func (f *WorkflowActionFields) SetRemoveCustomFields(removeCustomFields []int64) *WorkflowActionFields {
+	f.set("remove_custom_fields", removeCustomFields)
+	return f
+}
+
+// SetRemoveAllCustomFields sets the "remove_all_custom_fields" field.
+func (f *WorkflowActionFields) SetRemoveAllCustomFields(removeAllCustomFields bool) *WorkflowActionFields {
+	f.set("remove_all_custom_fields", removeAllCustomFields)
+	return f
+}
+
+// SetRemoveOwners sets the "remove_owners" field.
+func (f *WorkflowActionFields) SetRemoveOwners(removeOwners []int64) *WorkflowActionFields {
+	f.set("remove_owners", removeOwners)
+	return f
+}
+
+// SetRemoveAllOwners sets the "remove_all_owners" field.
+func (f *WorkflowActionFields) SetRemoveAllOwners(removeAllOwners bool) *WorkflowActionFields {
+	f.set("remove_all_owners", removeAllOwners)
+	return f
+}
+
+// SetRemoveViewUsers sets the "remove_view_users" field.
+func (f *WorkflowActionFields) SetRemoveViewUsers(removeViewUsers []int64) *WorkflowActionFields {
+	f.set("remove_view_users", removeViewUsers)
+	return f
+}
+
+// SetRemoveViewGroups sets the "remove_view_groups" field.
+func (f *WorkflowActionFields) SetRemoveViewGroups(removeViewGroups []int64) *WorkflowActionFields {
+	f.set("remove_view_groups", removeViewGroups)
+	return f
+}
+
+// SetRemoveChangeUsers sets the "remove_change_users" field.
+func (f *WorkflowActionFields) SetRemoveChangeUsers(removeChangeUsers []int64) *WorkflowActionFields {
+	f.set("remove_change_users", removeChangeUsers)
+	return f
+}
+
+// SetRemoveChangeGroups sets the "remove_change_groups" field.
+func (f *WorkflowActionFields) SetRemoveChangeGroups(removeChangeGroups []int64) *WorkflowActionFields {
+	f.set("remove_change_groups", removeChangeGroups)
+	return f
+}
+
+// SetRemoveAllPermissions sets the "remove_all_permissions" field.
+func (f *WorkflowActionFields) SetRemoveAllPermissions(removeAllPermissions bool) *WorkflowActionFields {
+	f.set("remove_all_permissions", removeAllPermissions)
+	return f
+}
+
+// SetEmail sets the "email" field.
+//
+// Settings for email actions.
+func (f *WorkflowActionFields) SetEmail(email *WorkflowActionEmail) *WorkflowActionFields {
+	f.set("email", email)
+	return f
+}
+
+// SetWebhook sets the "webhook" field.
+//
+// Settings for webhook actions.
+func (f *WorkflowActionFields) SetWebhook(webhook *WorkflowActionWebhook) *WorkflowActionFields {
+	f.set("webhook", webhook)
+	return f
+}
+
+type WorkflowTrigger struct {
+	// ID of the trigger. Zero for new triggers.
+	ID   int64               `json:"id,omitempty"`
+	Type WorkflowTriggerType `json:"type"`
+
+	// Document sources for consumption triggers.
+	Sources []WorkflowTriggerSource `json:"sources"`
+
+	// Only consume documents with a path matching this pattern (wildcards allowed).
+	FilterPath *string `json:"filter_path"`
+
+	// Only consume documents with a filename matching this pattern (wildcards allowed).
+	FilterFilename *string `json:"filter_filename"`
+
+	// Only consume documents fetched by this mail rule.
+	FilterMailrule         *int64            `json:"filter_mailrule"`
+	MatchingAlgorithm      MatchingAlgorithm `json:"matching_algorithm"`
+	Match                  string            `json:"match"`
+	IsInsensitive          bool              `json:"is_insensitive"`
+	FilterHasTags          []int64           `json:"filter_has_tags"`
+	FilterHasCorrespondent *int64            `json:"filter_has_correspondent"`
+	FilterHasDocumentType  *int64            `json:"filter_has_document_type"`
+
+	// Number of days to offset the schedule trigger by.
+	ScheduleOffsetDays            int64                            `json:"schedule_offset_days"`
+	ScheduleIsRecurring           bool                             `json:"schedule_is_recurring"`
+	ScheduleRecurringIntervalDays int64                            `json:"schedule_recurring_interval_days"`
+	ScheduleDateField             WorkflowTriggerScheduleDateField `json:"schedule_date_field"`
+
+	// Custom field for [WorkflowScheduleDateCustomField].
+	ScheduleDateCustomField *int64 `json:"schedule_date_custom_field"`
+}
+
+type WorkflowTriggerFields struct {
+	objectFields
+}
+
+var _ json.Marshaler = (*WorkflowTriggerFields)(nil)
+
+func NewWorkflowTriggerFields() *WorkflowTriggerFields {
+	return &WorkflowTriggerFields{objectFields{}}
+}
+
+// SetType sets the "type" field.
+func (f *WorkflowTriggerFields) SetType(typeValue WorkflowTriggerType) *WorkflowTriggerFields {
+	f.set("type", typeValue)
+	return f
+}
+
+// SetSources sets the "sources" field.
+//
+// Document sources for consumption triggers.
+func (f *WorkflowTriggerFields) SetSources(sources []WorkflowTriggerSource) *WorkflowTriggerFields {
+	f.set("sources", sources)
+	return f
+}
+
+// SetFilterPath sets the "filter_path" field.
+//
+// Only consume documents with a path matching this pattern (wildcards allowed).
+func (f *WorkflowTriggerFields) SetFilterPath(filterPath *string) *WorkflowTriggerFields {
+	f.set("filter_path", filterPath)
+	return f
+}
+
+// SetFilterFilename sets the "filter_filename" field.
+//
+// Only consume documents with a filename matching this pattern (wildcards allowed).
+func (f *WorkflowTriggerFields) SetFilterFilename(filterFilename *string) *WorkflowTriggerFields {
+	f.set("filter_filename", filterFilename)
+	return f
+}
+
+// SetFilterMailrule sets the "filter_mailrule" field.
+//
+// Only consume documents fetched by this mail rule.
+func (f *WorkflowTriggerFields) SetFilterMailrule(filterMailrule *int64) *WorkflowTriggerFields {
+	f.set("filter_mailrule", filterMailrule)
+	return f
+}
+
+// SetMatchingAlgorithm sets the "matching_algorithm" field.
+func (f *WorkflowTriggerFields) SetMatchingAlgorithm(matchingAlgorithm MatchingAlgorithm) *WorkflowTriggerFields {
+	f.set("matching_algorithm", matchingAlgorithm)
+	return f
+}
+
+// SetMatch sets the "match" field.
+func (f *WorkflowTriggerFields) SetMatch(match string) *WorkflowTriggerFields {
+	f.set("match", match)
+	return f
+}
+
+// SetIsInsensitive sets the "is_insensitive" field.
+func (f *WorkflowTriggerFields) SetIsInsensitive(isInsensitive bool) *WorkflowTriggerFields {
+	f.set("is_insensitive", isInsensitive)
+	return f
+}
+
+// SetFilterHasTags sets the "filter_has_tags" field.
+func (f *WorkflowTriggerFields) SetFilterHasTags(filterHasTags []int64) *WorkflowTriggerFields {
+	f.set("filter_has_tags", filterHasTags)
+	return f
+}
+
+// SetFilterHasCorrespondent sets the "filter_has_correspondent" field.
+func (f *WorkflowTriggerFields) SetFilterHasCorrespondent(filterHasCorrespondent *int64) *WorkflowTriggerFields {
+	f.set("filter_has_correspondent", filterHasCorrespondent)
+	return f
+}
+
+// SetFilterHasDocumentType sets the "filter_has_document_type" field.
+func (f *WorkflowTriggerFields) SetFilterHasDocumentType(filterHasDocumentType *int64) *WorkflowTriggerFields {
+	f.set("filter_has_document_type", filterHasDocumentType)
+	return f
+}
+
+// SetScheduleOffsetDays sets the "schedule_offset_days" field.
+//
+// Number of days to offset the schedule trigger by.
+func (f *WorkflowTriggerFields) SetScheduleOffsetDays(scheduleOffsetDays int64) *WorkflowTriggerFields {
+	f.set("schedule_offset_days", scheduleOffsetDays)
+	return f
+}
+
+// SetScheduleIsRecurring sets the "schedule_is_recurring" field.
+func (f *WorkflowTriggerFields) SetScheduleIsRecurring(scheduleIsRecurring bool) *WorkflowTriggerFields {
+	f.set("schedule_is_recurring", scheduleIsRecurring)
+	return f
+}
+
+// SetScheduleRecurringIntervalDays sets the "schedule_recurring_interval_days" field.
+func (f *WorkflowTriggerFields) SetScheduleRecurringIntervalDays(scheduleRecurringIntervalDays int64) *WorkflowTriggerFields {
+	f.set("schedule_recurring_interval_days", scheduleRecurringIntervalDays)
+	return f
+}
+
+// SetScheduleDateField sets the "schedule_date_field" field.
+func (f *WorkflowTriggerFields) SetScheduleDateField(scheduleDateField WorkflowTriggerScheduleDateField) *WorkflowTriggerFields {
+	f.set("schedule_date_field", scheduleDateField)
+	return f
+}
+
+// SetScheduleDateCustomField sets the "schedule_date_custom_field" field.
+//
+// Custom field for [WorkflowScheduleDateCustomField].
+func (f *WorkflowTriggerFields) SetScheduleDateCustomField(scheduleDateCustomField *int64) *WorkflowTriggerFields {
+	f.set("schedule_date_custom_field", scheduleDateCustomField)
+	return f
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type NoteUser struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+type Note struct {
+	ID int64
+
+	// Text of the note.
+	Note string
+
+	// Time at which the note was created.
+	Created time.Time
+
+	// Author of the note; nil if the user no longer exists.
+	User *NoteUser
+}
+
+type noteJSON struct {
+	ID      int64     `json:"id"`
+	Note    string    `json:"note"`
+	Created string    `json:"created"`
+	User    *NoteUser `json:"user"`
+}
+
+func (c *Client) convertNotes(resp *resty.Response) ([]Note, error) {
+	var result []Note
+
+	for _, i := range *resp.Result().(*[]noteJSON) {
+		n := Note{
+			ID:   i.ID,
+			Note: i.Note,
+			User: i.User,
+		}
+
+		if i.Created != "" {
+			created, err := parseServerTime(i.Created, c.loc)
+			if err != nil {
+				return nil, fmt.Errorf("note %d: %w", i.ID, err)
+			}
+
+			n.Created = created
+		}
+
+		result = append(result, n)
+	}
+
+	return result, nil
+}
+
+func (c *Client) documentNotes(ctx context.Context, method string, id int64, fn func(*resty.Request)) ([]Note, *Response, error) {
+	req := c.newRequest(ctx).
+		SetResult([]noteJSON(nil))
+
+	if fn != nil {
+		fn(req)
+	}
+
+	resp, err := req.Execute(method, fmt.Sprintf("api/documents/%d/notes/", id))
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	notes, err := c.convertNotes(resp)
+	if err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	return notes, wrapResponse(resp), nil
+}
+
+// ListDocumentNotes retrieves all notes on a document.
+func (c *Client) ListDocumentNotes(ctx context.Context, id int64) ([]Note, *Response, error) {
+	ctx = withOperation(ctx, "ListDocumentNotes")
+
+	return c.documentNotes(ctx, resty.MethodGet, id, nil)
+}
+
+// AddDocumentNote adds a note to a document. All notes on the document are
+// returned.
+func (c *Client) AddDocumentNote(ctx context.Context, id int64, text string) ([]Note, *Response, error) {
+	ctx = withOperation(ctx, "AddDocumentNote")
+
+	return c.documentNotes(ctx, resty.MethodPost, id, func(req *resty.Request) {
+		req.SetBody(map[string]string{
+			"note": text,
+		})
+	})
+}
+
+// DeleteDocumentNote removes a note from a document. The remaining notes are
+// returned.
+func (c *Client) DeleteDocumentNote(ctx context.Context, id, noteID int64) ([]Note, *Response, error) {
+	ctx = withOperation(ctx, "DeleteDocumentNote")
+
+	return c.documentNotes(ctx, resty.MethodDelete, id, func(req *resty.Request) {
+		req.SetQueryParam("id", strconv.FormatInt(noteID, 10))
+	})
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestDocumentNotes(t *testing.T) {
+	loc := time.FixedZone("Test", 2*60*60)
+
+	const notesJSON = `[
+		{
+			"id": 4,
+			"note": "auto-classified by rule X",
+			"created": "2024-02-03T10:11:12.345678",
+			"user": { "id": 2, "username": "hook", "first_name": "", "last_name": "" }
+		},
+		{
+			"id": 3,
+			"note": "check amount",
+			"created": "2024-01-05T08:00:00Z",
+			"user": null
+		}
+	]`
+
+	wantNotes := []Note{
+		{
+			ID:      4,
+			Note:    "auto-classified by rule X",
+			Created: time.Date(2024, time.February, 3, 10, 11, 12, 345678000, loc),
+			User: &NoteUser{
+				ID:       2,
+				Username: "hook",
+			},
+		},
+		{
+			ID:      3,
+			Note:    "check amount",
+			Created: time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		setup   func(*testing.T, *httpmock.MockTransport)
+		call    func(context.Context, *Client) ([]Note, *Response, error)
+		want    []Note
+		wantErr error
+	}{
+		{
+			name: "list",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/10/notes/",
+					httpmock.NewStringResponder(http.StatusOK, notesJSON))
+			},
+			call: func(ctx context.Context, c *Client) ([]Note, *Response, error) {
+				return c.ListDocumentNotes(ctx, 10)
+			},
+			want: wantNotes,
+		},
+		{
+			name: "add",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/11/notes/",
+					newJSONBodyResponder(t, map[string]any{"note": "auto-classified by rule X"},
+						httpmock.NewStringResponder(http.StatusOK, notesJSON)))
+			},
+			call: func(ctx context.Context, c *Client) ([]Note, *Response, error) {
+				return c.AddDocumentNote(ctx, 11, "auto-classified by rule X")
+			},
+			want: wantNotes,
+		},
+		{
+			name: "delete",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodDelete, "/api/documents/12/notes/",
+					"id=5",
+					httpmock.NewStringResponder(http.StatusOK, `[]`))
+			},
+			call: func(ctx context.Context, c *Client) ([]Note, *Response, error) {
+				return c.DeleteDocumentNote(ctx, 12, 5)
+			},
+		},
+		{
+			name: "bad timestamp",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/13/notes/",
+					httpmock.NewStringResponder(http.StatusOK, `[{"id": 1, "created": "yesterday"}]`))
+			},
+			call: func(ctx context.Context, c *Client) ([]Note, *Response, error) {
+				return c.ListDocumentNotes(ctx, 13)
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "not found",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/14/notes/",
+					httpmock.NewStringResponder(http.StatusNotFound, `{"detail": "Not found."}`))
+			},
+			call: func(ctx context.Context, c *Client) ([]Note, *Response, error) {
+				return c.ListDocumentNotes(ctx, 14)
+			},
+			wantErr: &RequestError{
+				StatusCode: http.StatusNotFound,
+				Message:    `{"detail":"Not found."}`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			tc.setup(t, transport)
+
+			c := New(Options{
+				transport:      transport,
+				ServerLocation: loc,
+			})
+
+			got, _, err := tc.call(context.Background(), c)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Notes error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Notes diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"encoding"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -19,6 +20,8 @@ type PageToken struct {
 }
 
 var _ query.Encoder = (*PageToken)(nil)
+var _ encoding.TextMarshaler = (*PageToken)(nil)
+var _ encoding.TextUnmarshaler = (*PageToken)(nil)
 
 func (t *PageToken) values() (int, int) {
 	number := 1
@@ -52,6 +55,34 @@ func (t *PageToken) EncodeValues(_ string, v *url.Values) error {
 	return nil
 }
 
+// MarshalText encodes the token as a query string, e.g.
+// "page=3&page_size=25". Tokens can be persisted and restored using
+// [PageToken.UnmarshalText], including as part of JSON documents.
+func (t *PageToken) MarshalText() ([]byte, error) {
+	var values url.Values = map[string][]string{}
+
+	if err := t.EncodeValues("", &values); err != nil {
+		return nil, err
+	}
+
+	return []byte(values.Encode()), nil
+}
+
+func (t *PageToken) UnmarshalText(text []byte) error {
+	parsed, err := pageTokenFromURL("?" + string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = PageToken{}
+
+	if parsed != nil {
+		*t = *parsed
+	}
+
+	return nil
+}
+
 func pageTokenFromURL(raw string) (*PageToken, error) {
 	if raw == "" {
 		return nil, nil
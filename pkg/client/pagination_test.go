@@ -1,6 +1,8 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -136,3 +138,59 @@ func TestPageTokenFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestPageTokenText(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		input    *PageToken
+		wantText string
+		want     PageToken
+	}{
+		{
+			name:     "zero",
+			input:    &PageToken{},
+			wantText: "page=1&page_size=25",
+			want:     PageToken{number: 1, size: defaultPerPage},
+		},
+		{
+			name:     "custom values",
+			input:    &PageToken{number: 1234, size: 100},
+			wantText: "page=1234&page_size=100",
+			want:     PageToken{number: 1234, size: 100},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			text, err := tc.input.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() failed: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantText, string(text)); diff != "" {
+				t.Errorf("MarshalText() diff (-want +got):\n%s", diff)
+			}
+
+			buf, err := json.Marshal(struct{ Token *PageToken }{tc.input})
+			if err != nil {
+				t.Fatalf("Marshal() failed: %v", err)
+			}
+
+			var got struct{ Token *PageToken }
+
+			if err := json.Unmarshal(buf, &got); err != nil {
+				t.Fatalf("Unmarshal() failed: %v", err)
+			}
+
+			if diff := cmp.Diff(&tc.want, got.Token, cmp.AllowUnexported(PageToken{})); diff != "" {
+				t.Errorf("Unmarshal() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPageTokenUnmarshalTextError(t *testing.T) {
+	var got PageToken
+
+	if err := got.UnmarshalText([]byte("page=abc")); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("UnmarshalText() error = %v, want %v", err, strconv.ErrSyntax)
+	}
+}
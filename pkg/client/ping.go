@@ -4,6 +4,8 @@ import "context"
 
 // Ping tests whether the API is available.
 func (c *Client) Ping(ctx context.Context) error {
+	ctx = withOperation(ctx, "Ping")
+
 	resp, err := c.newRequest(ctx).
 		Get("api/")
 
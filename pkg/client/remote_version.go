@@ -10,6 +10,8 @@ type RemoteVersion struct {
 }
 
 func (c *Client) GetRemoteVersion(ctx context.Context) (*RemoteVersion, *Response, error) {
+	ctx = withOperation(ctx, "GetRemoteVersion")
+
 	resp, err := c.newRequest(ctx).
 		SetResult(&RemoteVersion{}).
 		Get("api/remote_version/")
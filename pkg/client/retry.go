@@ -0,0 +1,66 @@
+package client
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hansmi/paperhooks/internal/httptransport"
+)
+
+// RetryPolicy controls automatic retries of requests failing due to network
+// errors, server errors (5xx) or rate limiting (429 Too Many Requests). Only
+// requests with an idempotent method (GET, PUT, DELETE) are retried. Delays
+// requested by the server via the Retry-After header are honoured.
+type RetryPolicy struct {
+	// Maximum number of retries after the initial attempt. Defaults to zero
+	// (retries disabled).
+	MaxRetries int
+
+	// Delay before the first retry. Subsequent delays grow exponentially.
+	// Defaults to 500 milliseconds.
+	InitialInterval time.Duration
+
+	// Upper bound for the delay between attempts. Defaults to 30 seconds.
+	MaxInterval time.Duration
+
+	// Upper bound for delays requested via Retry-After. Responses asking for
+	// longer delays are not retried. Defaults to 5 minutes.
+	MaxRetryAfter time.Duration
+}
+
+func (p RetryPolicy) wrap(base http.RoundTripper, logger Logger) http.RoundTripper {
+	if p.InitialInterval <= 0 {
+		p.InitialInterval = 500 * time.Millisecond
+	}
+
+	if p.MaxInterval <= 0 {
+		p.MaxInterval = 30 * time.Second
+	}
+
+	if p.MaxRetryAfter <= 0 {
+		p.MaxRetryAfter = 5 * time.Minute
+	}
+
+	return httptransport.Retry(base, httptransport.RetryOptions{
+		MaxRetries:    p.MaxRetries,
+		MaxRetryAfter: p.MaxRetryAfter,
+		NewBackOff: func() backoff.BackOff {
+			b := backoff.NewExponentialBackOff()
+			b.InitialInterval = p.InitialInterval
+			b.MaxInterval = p.MaxInterval
+			b.MaxElapsedTime = 0
+			b.Reset()
+
+			return b
+		},
+		Notify: func(req *http.Request, err error, delay time.Duration) {
+			logAttrs(logger, slog.LevelWarn, "Request failed, retrying",
+				slog.String("method", req.Method),
+				slog.String("url", req.URL.Redacted()),
+				slog.Duration("delay", delay),
+				slog.Any("error", err))
+		},
+	})
+}
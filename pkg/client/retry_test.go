@@ -0,0 +1,240 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+// sequenceResponder returns the given responders in order. The last one is
+// repeated.
+func sequenceResponder(t *testing.T, calls *int, responders ...httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		idx := min(*calls, len(responders)-1)
+
+		*calls++
+
+		return responders[idx](req)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errNetwork := errors.New("connection reset")
+
+	serverError := httpmock.NewStringResponder(http.StatusBadGateway, "")
+	tagResponse := httpmock.NewStringResponder(http.StatusOK, `{"id": 1, "name": "tag"}`)
+
+	for _, tc := range []struct {
+		name       string
+		policy     RetryPolicy
+		responders []httpmock.Responder
+		call       func(context.Context, *Client) error
+		wantCalls  int
+		wantErr    error
+	}{
+		{
+			name:       "disabled",
+			responders: []httpmock.Responder{serverError, tagResponse},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetTag(ctx, 1)
+				return err
+			},
+			wantCalls: 1,
+			wantErr: &RequestError{
+				StatusCode: http.StatusBadGateway,
+				Message:    "502 Bad Gateway",
+			},
+		},
+		{
+			name:       "server error",
+			policy:     RetryPolicy{MaxRetries: 3},
+			responders: []httpmock.Responder{serverError, serverError, tagResponse},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetTag(ctx, 1)
+				return err
+			},
+			wantCalls: 3,
+		},
+		{
+			name:   "network error",
+			policy: RetryPolicy{MaxRetries: 1},
+			responders: []httpmock.Responder{
+				httpmock.NewErrorResponder(errNetwork),
+				tagResponse,
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetTag(ctx, 1)
+				return err
+			},
+			wantCalls: 2,
+		},
+		{
+			name:       "exhausted",
+			policy:     RetryPolicy{MaxRetries: 2},
+			responders: []httpmock.Responder{serverError},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetTag(ctx, 1)
+				return err
+			},
+			wantCalls: 3,
+			wantErr: &RequestError{
+				StatusCode: http.StatusBadGateway,
+				Message:    "502 Bad Gateway",
+			},
+		},
+		{
+			name:   "too many requests",
+			policy: RetryPolicy{MaxRetries: 1},
+			responders: []httpmock.Responder{
+				httpmock.NewStringResponder(http.StatusTooManyRequests, "").
+					HeaderSet(http.Header{"Retry-After": []string{"0"}}),
+				tagResponse,
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetTag(ctx, 1)
+				return err
+			},
+			wantCalls: 2,
+		},
+		{
+			name:   "retry after too long",
+			policy: RetryPolicy{MaxRetries: 1, MaxRetryAfter: time.Minute},
+			responders: []httpmock.Responder{
+				httpmock.NewStringResponder(http.StatusServiceUnavailable, "").
+					HeaderSet(http.Header{"Retry-After": []string{"3600"}}),
+				tagResponse,
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetTag(ctx, 1)
+				return err
+			},
+			wantCalls: 1,
+			wantErr: &RequestError{
+				StatusCode: http.StatusServiceUnavailable,
+				Message:    "503 Service Unavailable",
+			},
+		},
+		{
+			name:       "client error",
+			policy:     RetryPolicy{MaxRetries: 3},
+			responders: []httpmock.Responder{httpmock.NewStringResponder(http.StatusNotFound, "")},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetTag(ctx, 1)
+				return err
+			},
+			wantCalls: 1,
+			wantErr: &RequestError{
+				StatusCode: http.StatusNotFound,
+				Message:    "404 Not Found",
+			},
+		},
+		{
+			name:       "update",
+			policy:     RetryPolicy{MaxRetries: 1},
+			responders: []httpmock.Responder{serverError, tagResponse},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.UpdateTag(ctx, 1, &Tag{Name: "tag"})
+				return err
+			},
+			wantCalls: 2,
+		},
+		{
+			name:       "create not retried",
+			policy:     RetryPolicy{MaxRetries: 3},
+			responders: []httpmock.Responder{serverError},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.CreateTag(ctx, NewTagFields().SetName("tag"))
+				return err
+			},
+			wantCalls: 1,
+			wantErr: &RequestError{
+				StatusCode: http.StatusBadGateway,
+				Message:    "502 Bad Gateway",
+			},
+		},
+		{
+			name:   "upload not retried",
+			policy: RetryPolicy{MaxRetries: 3},
+			responders: []httpmock.Responder{
+				serverError,
+				httpmock.NewStringResponder(http.StatusOK, `"task"`),
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.UploadDocument(ctx, strings.NewReader("content"), DocumentUploadOptions{})
+				return err
+			},
+			wantCalls: 1,
+			wantErr: &RequestError{
+				StatusCode: http.StatusBadGateway,
+				Message:    "502 Bad Gateway",
+			},
+		},
+		{
+			name:   "upload with retry allowed",
+			policy: RetryPolicy{MaxRetries: 3},
+			responders: []httpmock.Responder{
+				serverError,
+				func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					if err != nil {
+						return nil, err
+					}
+
+					if !strings.Contains(string(body), "\ncontent\r\n") {
+						t.Errorf("Upload body is missing content: %q", body)
+					}
+
+					return httpmock.NewStringResponse(http.StatusOK, `"task"`), nil
+				},
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.UploadDocument(ctx, strings.NewReader("content"), DocumentUploadOptions{
+					AllowRetry: true,
+				})
+				return err
+			},
+			wantCalls: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+
+			responder := sequenceResponder(t, &calls, tc.responders...)
+
+			transport := newMockTransport(t)
+			transport.RegisterResponder(http.MethodGet, "/api/tags/1/", responder)
+			transport.RegisterResponder(http.MethodPut, "/api/tags/1/", responder)
+			transport.RegisterResponder(http.MethodPost, "/api/tags/", responder)
+			transport.RegisterResponder(http.MethodPost, "/api/documents/post_document/", responder)
+
+			policy := tc.policy
+			policy.InitialInterval = time.Millisecond
+			policy.MaxInterval = time.Millisecond
+
+			c := New(Options{
+				transport: transport,
+				Retry:     policy,
+			})
+
+			err := tc.call(context.Background(), c)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Error diff (-want +got):\n%s", diff)
+			}
+
+			if calls != tc.wantCalls {
+				t.Errorf("Got %d calls, want %d", calls, tc.wantCalls)
+			}
+		})
+	}
+}
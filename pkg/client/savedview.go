@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+func (c *Client) savedViewCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/saved_views/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(SavedView).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListSavedViewsOptions).Page = page
+		},
+	}
+}
+
+type ListSavedViewsOptions struct {
+	ListOptions
+}
+
+func (c *Client) ListSavedViews(ctx context.Context, opts ListSavedViewsOptions) ([]SavedView, *Response, error) {
+	ctx = withOperation(ctx, "ListSavedViews")
+
+	return crudList[SavedView](ctx, c.savedViewCrudOpts(), opts)
+}
+
+// ListAllSavedViews iterates over all saved views matching the filters
+// specified in opts, invoking handler for each.
+func (c *Client) ListAllSavedViews(ctx context.Context, opts ListSavedViewsOptions, handler func(context.Context, SavedView) error) error {
+	ctx = withOperation(ctx, "ListAllSavedViews")
+
+	return crudListAll[SavedView](ctx, c.savedViewCrudOpts(), opts, handler)
+}
+
+// IterAllSavedViews returns an iterator over all saved views matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllSavedViews(ctx context.Context, opts ListSavedViewsOptions) iter.Seq2[SavedView, error] {
+	ctx = withOperation(ctx, "IterAllSavedViews")
+
+	return crudIterAll[SavedView](ctx, c.savedViewCrudOpts(), opts)
+}
+
+func (c *Client) GetSavedView(ctx context.Context, id int64) (*SavedView, *Response, error) {
+	ctx = withOperation(ctx, "GetSavedView")
+
+	return crudGet[SavedView](ctx, c.savedViewCrudOpts(), id)
+}
+
+func (c *Client) CreateSavedView(ctx context.Context, data *SavedViewFields) (*SavedView, *Response, error) {
+	ctx = withOperation(ctx, "CreateSavedView")
+
+	return crudCreate[SavedView](ctx, c.savedViewCrudOpts(), data)
+}
+
+func (c *Client) UpdateSavedView(ctx context.Context, id int64, data *SavedView) (*SavedView, *Response, error) {
+	ctx = withOperation(ctx, "UpdateSavedView")
+
+	return crudUpdate[SavedView](ctx, c.savedViewCrudOpts(), id, data)
+}
+
+func (c *Client) PatchSavedView(ctx context.Context, id int64, data *SavedViewFields) (*SavedView, *Response, error) {
+	ctx = withOperation(ctx, "PatchSavedView")
+
+	return crudPatch[SavedView](ctx, c.savedViewCrudOpts(), id, data)
+}
+
+func (c *Client) DeleteSavedView(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteSavedView")
+
+	return crudDelete[SavedView](ctx, c.savedViewCrudOpts(), id)
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestFilterRule(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		rule     FilterRule
+		wantJSON string
+	}{
+		{
+			name:     "null",
+			rule:     FilterRule{RuleType: FilterRuleHasAnyTag},
+			wantJSON: `{"rule_type":7,"value":null}`,
+		},
+		{
+			name:     "string",
+			rule:     NewFilterRule(FilterRuleTitleContains, "invoice"),
+			wantJSON: `{"rule_type":0,"value":"invoice"}`,
+		},
+		{
+			name:     "id",
+			rule:     NewFilterRuleID(FilterRuleHasTag, 123),
+			wantJSON: `{"rule_type":6,"value":"123"}`,
+		},
+		{
+			name:     "bool",
+			rule:     NewFilterRuleBool(FilterRuleHasAnyTag, false),
+			wantJSON: `{"rule_type":7,"value":"false"}`,
+		},
+		{
+			name:     "date",
+			rule:     NewFilterRuleDate(FilterRuleCreatedAfter, time.Date(2023, time.March, 4, 23, 0, 0, 0, time.UTC)),
+			wantJSON: `{"rule_type":9,"value":"2023-03-04"}`,
+		},
+		{
+			name:     "mime type",
+			rule:     NewFilterRule(FilterRuleMimeTypeIs, "application/pdf"),
+			wantJSON: `{"rule_type":47,"value":"application/pdf"}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(tc.rule)
+			if err != nil {
+				t.Fatalf("Marshal() failed: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantJSON, string(got)); diff != "" {
+				t.Errorf("Marshal() diff (-want +got):\n%s", diff)
+			}
+
+			var decoded FilterRule
+
+			if err := json.Unmarshal(got, &decoded); err != nil {
+				t.Fatalf("Unmarshal() failed: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.rule, decoded); diff != "" {
+				t.Errorf("Unmarshal() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterRuleValues(t *testing.T) {
+	if got, err := NewFilterRuleID(FilterRuleHasTag, 99).IDValue(); err != nil || got != 99 {
+		t.Errorf("IDValue() returned (%d, %v), want 99", got, err)
+	}
+
+	if got, err := NewFilterRuleBool(FilterRuleIsInInbox, true).BoolValue(); err != nil || !got {
+		t.Errorf("BoolValue() returned (%t, %v), want true", got, err)
+	}
+
+	want := time.Date(2021, time.December, 24, 0, 0, 0, 0, time.UTC)
+
+	if got, err := NewFilterRule(FilterRuleAddedBefore, "2021-12-24").DateValue(time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("DateValue() returned (%v, %v), want %v", got, err, want)
+	}
+
+	if _, err := (FilterRule{RuleType: FilterRuleHasTag}).IDValue(); err == nil {
+		t.Errorf("IDValue() without value succeeded")
+	}
+
+	if _, err := NewFilterRule(FilterRuleHasTag, "abc").IDValue(); err == nil {
+		t.Errorf("IDValue() with invalid value succeeded")
+	}
+
+	if got := FilterRuleDoesNotHaveCorrespondentIn.String(); got != "DoesNotHaveCorrespondentIn" {
+		t.Errorf("String() returned %q", got)
+	}
+}
+
+func TestListSavedViews(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/saved_views/",
+		"page=1&page_size=25",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"count": 1,
+			"results": [
+				{
+					"id": 3,
+					"name": "Inbox",
+					"show_on_dashboard": true,
+					"show_in_sidebar": false,
+					"sort_field": "created",
+					"sort_reverse": true,
+					"filter_rules": [
+						{ "rule_type": 6, "value": "1" },
+						{ "rule_type": 7, "value": null }
+					],
+					"page_size": 50,
+					"display_fields": ["title", "tag"],
+					"owner": 2
+				}
+			]
+		}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.ListSavedViews(context.Background(), ListSavedViewsOptions{})
+	if err != nil {
+		t.Fatalf("ListSavedViews() failed: %v", err)
+	}
+
+	want := []SavedView{
+		{
+			ID:              3,
+			Name:            "Inbox",
+			ShowOnDashboard: true,
+			SortField:       String("created"),
+			SortReverse:     true,
+			FilterRules: []FilterRule{
+				NewFilterRuleID(FilterRuleHasTag, 1),
+				{RuleType: FilterRuleHasAnyTag},
+			},
+			PageSize:      Int64(50),
+			DisplayFields: []string{"title", "tag"},
+			Owner:         Int64(2),
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("ListSavedViews() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestCreateSavedView(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodPost, "/api/saved_views/",
+		newJSONBodyResponder(t, map[string]any{
+			"name":              "Recent",
+			"show_on_dashboard": true,
+			"filter_rules": []map[string]any{
+				{"rule_type": 14, "value": "2024-01-01"},
+			},
+		}, httpmock.NewStringResponder(http.StatusCreated, `{
+			"id": 17,
+			"name": "Recent"
+		}`)))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.CreateSavedView(context.Background(), NewSavedViewFields().
+		SetName("Recent").
+		SetShowOnDashboard(true).
+		SetFilterRules([]FilterRule{
+			NewFilterRuleDate(FilterRuleAddedAfter, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		}))
+	if err != nil {
+		t.Fatalf("CreateSavedView() failed: %v", err)
+	}
+
+	want := &SavedView{ID: 17, Name: "Recent"}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("CreateSavedView() diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/go-querystring/query"
+)
+
+// GlobalSearchResult contains the objects matching a global search, grouped
+// by object type.
+type GlobalSearchResult struct {
+	// Total number of matching objects across all types.
+	Total int64 `json:"total"`
+
+	Documents      []Document      `json:"documents"`
+	SavedViews     []SavedView     `json:"saved_views"`
+	Tags           []Tag           `json:"tags"`
+	Correspondents []Correspondent `json:"correspondents"`
+	DocumentTypes  []DocumentType  `json:"document_types"`
+	StoragePaths   []StoragePath   `json:"storage_paths"`
+	Users          []User          `json:"users"`
+	Groups         []Group         `json:"groups"`
+	MailRules      []MailRule      `json:"mail_rules"`
+	MailAccounts   []MailAccount   `json:"mail_accounts"`
+	Workflows      []Workflow      `json:"workflows"`
+	CustomFields   []CustomField   `json:"custom_fields"`
+}
+
+type GlobalSearchOptions struct {
+	// Search document titles only instead of using the full-text index.
+	DBOnly bool `url:"db_only,omitempty"`
+}
+
+// GlobalSearch looks up objects of all types matching the given query. The
+// server returns a small number of matches per type.
+func (c *Client) GlobalSearch(ctx context.Context, q string, opts GlobalSearchOptions) (*GlobalSearchResult, *Response, error) {
+	ctx = withOperation(ctx, "GlobalSearch")
+
+	req := c.newRequest(ctx).
+		SetResult(&GlobalSearchResult{}).
+		SetQueryParam("query", q)
+
+	if values, err := query.Values(opts); err != nil {
+		return nil, nil, err
+	} else {
+		req.SetQueryParamsFromValues(values)
+	}
+
+	resp, err := req.Get("api/search/")
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	return resp.Result().(*GlobalSearchResult), wrapResponse(resp), nil
+}
+
+type SearchAutocompleteOptions struct {
+	// Maximum number of suggestions; the server default is used when zero.
+	Limit int `url:"limit,omitempty"`
+}
+
+// SearchAutocomplete returns search terms from the full-text index starting
+// with the given term.
+func (c *Client) SearchAutocomplete(ctx context.Context, term string, opts SearchAutocompleteOptions) ([]string, *Response, error) {
+	ctx = withOperation(ctx, "SearchAutocomplete")
+
+	var result []string
+
+	req := c.newRequest(ctx).
+		SetResult(&result).
+		SetQueryParam("term", term)
+
+	if values, err := query.Values(opts); err != nil {
+		return nil, nil, err
+	} else {
+		req.SetQueryParamsFromValues(values)
+	}
+
+	resp, err := req.Get("api/search/autocomplete/")
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	return result, wrapResponse(resp), nil
+}
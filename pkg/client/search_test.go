@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestGlobalSearch(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		setup   func(*testing.T, *httpmock.MockTransport)
+		query   string
+		opts    GlobalSearchOptions
+		want    *GlobalSearchResult
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/search/",
+					"query=acme",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"total": 4,
+						"documents": [{"id": 12, "title": "ACME invoice"}],
+						"saved_views": [],
+						"tags": [{"id": 3, "name": "acme"}],
+						"correspondents": [{"id": 4, "name": "ACME Corp."}],
+						"document_types": [],
+						"storage_paths": [],
+						"users": [{"id": 9, "username": "acme-bot"}],
+						"groups": [],
+						"mail_rules": [],
+						"mail_accounts": [],
+						"workflows": [],
+						"custom_fields": []
+					}`))
+			},
+			query: "acme",
+			want: &GlobalSearchResult{
+				Total:          4,
+				Documents:      []Document{{ID: 12, Title: "ACME invoice"}},
+				Tags:           []Tag{{ID: 3, Name: "acme"}},
+				Correspondents: []Correspondent{{ID: 4, Name: "ACME Corp."}},
+				Users:          []User{{ID: 9, Username: "acme-bot"}},
+			},
+		},
+		{
+			name: "database only",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/search/",
+					"query=x&db_only=true",
+					httpmock.NewStringResponder(http.StatusOK, `{"total": 0}`))
+			},
+			query: "x",
+			opts: GlobalSearchOptions{
+				DBOnly: true,
+			},
+			want: &GlobalSearchResult{},
+		},
+		{
+			name: "error",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/search/",
+					httpmock.NewStringResponder(http.StatusBadRequest, `"Query must be at least 3 characters"`))
+			},
+			query: "a",
+			wantErr: &RequestError{
+				StatusCode: http.StatusBadRequest,
+				Message:    `"Query must be at least 3 characters"`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			tc.setup(t, transport)
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			got, _, err := c.GlobalSearch(context.Background(), tc.query, tc.opts)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("GlobalSearch() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("GlobalSearch() result diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchAutocomplete(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/search/autocomplete/",
+		"term=inv&limit=3",
+		httpmock.NewStringResponder(http.StatusOK, `["invoice", "inventory", "invest"]`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.SearchAutocomplete(context.Background(), "inv", SearchAutocompleteOptions{
+		Limit: 3,
+	})
+	if err != nil {
+		t.Fatalf("SearchAutocomplete() failed: %v", err)
+	}
+
+	want := []string{"invoice", "inventory", "invest"}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SearchAutocomplete() diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"fmt"
+	"time"
+)
+
+// parseServerTime parses a timestamp reported by the server. Timestamps
+// without an explicit offset are interpreted in the given location.
+func parseServerTime(value string, loc *time.Location) (time.Time, error) {
+	if ts, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return ts, nil
+	}
+
+	for _, layout := range []string{
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02 15:04:05.999999999",
+		time.DateOnly,
+	} {
+		if ts, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return ts, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized timestamp format: %q", value)
+}
@@ -0,0 +1,291 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hansmi/paperhooks/internal/httptransport"
+)
+
+// ErrSessionLogin is returned when logging in via [SessionAuth] fails.
+var ErrSessionLogin = errors.New("session login failed")
+
+// SessionAuth authenticates using the login form of the Paperless web
+// interface, e.g. when token and basic authentication are disabled.
+//
+// The login happens before the first request. The session and CSRF cookies
+// are kept in a cookie jar separate from the one in the HTTP client. Requests
+// with an unsafe method (POST, PUT, PATCH, DELETE) carry the CSRF token. When
+// the server no longer accepts an established session, e.g. because it
+// expired, the login is repeated and the request sent once more. Responses
+// denying permission for an authenticated user are returned as-is.
+type SessionAuth struct {
+	Username string
+	Password string
+
+	// Prefix for the session and CSRF cookie names. Must match the
+	// PAPERLESS_COOKIE_PREFIX setting of the server.
+	CookiePrefix string
+}
+
+var _ AuthMechanism = (*SessionAuth)(nil)
+
+func (a *SessionAuth) authenticate(opts Options, c *resty.Client) {
+	// Only returns an error for invalid options.
+	jar, _ := cookiejar.New(nil)
+
+	// Cookies are managed by the session transport.
+	c.SetCookieJar(nil)
+
+	c.SetTransport(&sessionTransport{
+		base:        c.GetClient().Transport,
+		username:    a.Username,
+		password:    a.Password,
+		loginURL:    strings.TrimRight(opts.BaseURL, "/") + "/accounts/login/",
+		sessionName: a.CookiePrefix + "sessionid",
+		csrfName:    a.CookiePrefix + "csrftoken",
+		jar:         jar,
+	})
+}
+
+type sessionTransport struct {
+	base        http.RoundTripper
+	username    string
+	password    string
+	loginURL    string
+	sessionName string
+	csrfName    string
+	jar         http.CookieJar
+
+	mu sync.Mutex
+
+	// Incremented with every successful login. Zero when not logged in.
+	generation uint64
+}
+
+var _ http.RoundTripper = (*sessionTransport)(nil)
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+
+	return false
+}
+
+func (t *sessionTransport) cookie(u *url.URL, name string) string {
+	for _, c := range t.jar.Cookies(u) {
+		if c.Name == name {
+			return c.Value
+		}
+	}
+
+	return ""
+}
+
+func (t *sessionTransport) login(ctx context.Context) error {
+	client := &http.Client{
+		Transport: t.base,
+		Jar:       t.jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	loginURL, err := url.Parse(t.loginURL)
+	if err != nil {
+		return err
+	}
+
+	// Fetch the login form to receive a CSRF cookie.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, loginURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	httptransport.DiscardResponse(resp)
+
+	csrfToken := t.cookie(loginURL, t.csrfName)
+	if csrfToken == "" {
+		return fmt.Errorf("%w: server did not set %q cookie (status %q)", ErrSessionLogin, t.csrfName, resp.Status)
+	}
+
+	form := url.Values{
+		"csrfmiddlewaretoken": []string{csrfToken},
+		"login":               []string{t.username},
+		"username":            []string{t.username},
+		"password":            []string{t.password},
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, loginURL.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", loginURL.String())
+	req.Header.Set("X-CSRFToken", csrfToken)
+
+	resp, err = client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	httptransport.DiscardResponse(resp)
+
+	// A successful login redirects to the application.
+	if !(resp.StatusCode >= 300 && resp.StatusCode < 400) || t.cookie(loginURL, t.sessionName) == "" {
+		return fmt.Errorf("%w: status %q", ErrSessionLogin, resp.Status)
+	}
+
+	return nil
+}
+
+// Message of the Django REST framework for unauthenticated requests.
+const notAuthenticatedDetail = "Authentication credentials were not provided."
+
+// peekResponseDetail returns the "detail" message of a JSON error response.
+// The body remains readable for the caller.
+func peekResponseDetail(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(resp.Body, httptransport.DrainLimit))
+
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), resp.Body), resp.Body}
+
+	if err != nil {
+		return ""
+	}
+
+	var body struct {
+		Detail string `json:"detail"`
+	}
+
+	if json.Unmarshal(buf, &body) != nil {
+		return ""
+	}
+
+	return body.Detail
+}
+
+// sessionRejected returns whether a response indicates that the request
+// wasn't authenticated, as opposed to the user lacking permission.
+func (t *sessionTransport) sessionRejected(r *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return true
+
+	case http.StatusForbidden:
+		// The server may have removed the session cookie.
+		return t.cookie(r.URL, t.sessionName) == "" ||
+			peekResponseDetail(resp) == notAuthenticatedDetail
+	}
+
+	return false
+}
+
+// session logs in unless a session newer than the given stale generation
+// exists. Returns the generation of the current session and whether it was
+// established by the call.
+func (t *sessionTransport) session(ctx context.Context, stale uint64) (uint64, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.generation != 0 && t.generation != stale {
+		return t.generation, false, nil
+	}
+
+	if err := t.login(ctx); err != nil {
+		return 0, false, err
+	}
+
+	t.generation++
+
+	return t.generation, true, nil
+}
+
+func (t *sessionTransport) send(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+
+	for _, c := range t.jar.Cookies(r.URL) {
+		r.AddCookie(c)
+	}
+
+	if !isSafeMethod(r.Method) {
+		r.Header.Set("X-CSRFToken", t.cookie(r.URL, t.csrfName))
+
+		if r.Header.Get("Referer") == "" {
+			// Django verifies the referer on secure connections.
+			r.Header.Set("Referer", t.loginURL)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err == nil {
+		t.jar.SetCookies(r.URL, resp.Cookies())
+	}
+
+	return resp, err
+}
+
+func (t *sessionTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	generation, fresh, err := t.session(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.send(r)
+	if err != nil || fresh || !t.sessionRejected(r, resp) {
+		return resp, err
+	}
+
+	// The session has expired.
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		// Body can't be sent again.
+		return resp, nil
+	}
+
+	if _, _, err := t.session(ctx, generation); err != nil {
+		httptransport.DiscardResponse(resp)
+		return nil, err
+	}
+
+	retry := r
+
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+
+		retry = r.Clone(ctx)
+		retry.Body = body
+	}
+
+	httptransport.DiscardResponse(resp)
+
+	return t.send(retry)
+}
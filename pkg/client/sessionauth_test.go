@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// fakeSessionServer emulates the Django login flow and session handling of
+// Paperless.
+type fakeSessionServer struct {
+	prefix   string
+	username string
+	password string
+
+	mu       sync.Mutex
+	logins   int
+	sessions map[string]bool
+}
+
+func (s *fakeSessionServer) cookie(r *http.Request, name string) string {
+	if c, err := r.Cookie(s.prefix + name); err == nil {
+		return c.Value
+	}
+
+	return ""
+}
+
+func (s *fakeSessionServer) expireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.sessions)
+}
+
+func (s *fakeSessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	csrfToken := s.cookie(r, "csrftoken")
+
+	if r.URL.Path == "/accounts/login/" {
+		switch r.Method {
+		case http.MethodGet:
+			http.SetCookie(w, &http.Cookie{Name: s.prefix + "csrftoken", Value: "csrf1234", Path: "/"})
+			fmt.Fprint(w, "<form>")
+
+		case http.MethodPost:
+			if csrfToken == "" || r.PostFormValue("csrfmiddlewaretoken") != csrfToken {
+				http.Error(w, "CSRF verification failed", http.StatusForbidden)
+				return
+			}
+
+			if r.PostFormValue("login") != s.username || r.PostFormValue("password") != s.password {
+				fmt.Fprint(w, "<form>invalid credentials")
+				return
+			}
+
+			s.logins++
+
+			session := fmt.Sprintf("session%d", s.logins)
+			s.sessions[session] = true
+
+			http.SetCookie(w, &http.Cookie{Name: s.prefix + "sessionid", Value: session, Path: "/"})
+			http.Redirect(w, r, "/", http.StatusFound)
+		}
+
+		return
+	}
+
+	if !s.sessions[s.cookie(r, "sessionid")] {
+		http.Error(w, `{"detail": "Authentication credentials were not provided."}`, http.StatusForbidden)
+		return
+	}
+
+	if !isSafeMethod(r.Method) && (csrfToken == "" || r.Header.Get("X-CSRFToken") != csrfToken) {
+		http.Error(w, `{"detail": "CSRF Failed: CSRF token missing."}`, http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/":
+		fmt.Fprint(w, `{}`)
+
+	case r.Method == http.MethodPost && r.URL.Path == "/api/tags/":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 1, "name": "test"}`)
+
+	case r.Method == http.MethodGet && r.URL.Path == "/api/documents/1/":
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"detail": "You do not have permission to perform this action."}`)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestSessionAuth(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		auth       SessionAuth
+		run        func(*testing.T, *fakeSessionServer, *Client) error
+		wantErr    error
+		wantLogins int
+	}{
+		{
+			name: "ping",
+			auth: SessionAuth{Username: "user", Password: "secret"},
+			run: func(t *testing.T, _ *fakeSessionServer, c *Client) error {
+				return c.Ping(context.Background())
+			},
+			wantLogins: 1,
+		},
+		{
+			name: "unsafe method",
+			auth: SessionAuth{Username: "user", Password: "secret"},
+			run: func(t *testing.T, _ *fakeSessionServer, c *Client) error {
+				if err := c.Ping(context.Background()); err != nil {
+					return err
+				}
+
+				_, _, err := c.CreateTag(context.Background(), NewTagFields().SetName("test"))
+
+				return err
+			},
+			wantLogins: 1,
+		},
+		{
+			name: "cookie prefix",
+			auth: SessionAuth{Username: "user", Password: "secret", CookiePrefix: "paperless_"},
+			run: func(t *testing.T, _ *fakeSessionServer, c *Client) error {
+				_, _, err := c.CreateTag(context.Background(), NewTagFields().SetName("test"))
+				return err
+			},
+			wantLogins: 1,
+		},
+		{
+			name: "session expired",
+			auth: SessionAuth{Username: "user", Password: "secret"},
+			run: func(t *testing.T, srv *fakeSessionServer, c *Client) error {
+				if err := c.Ping(context.Background()); err != nil {
+					return err
+				}
+
+				srv.expireSessions()
+
+				_, _, err := c.CreateTag(context.Background(), NewTagFields().SetName("test"))
+
+				return err
+			},
+			wantLogins: 2,
+		},
+		{
+			name: "permission denied",
+			auth: SessionAuth{Username: "user", Password: "secret"},
+			run: func(t *testing.T, _ *fakeSessionServer, c *Client) error {
+				if err := c.Ping(context.Background()); err != nil {
+					return err
+				}
+
+				_, _, err := c.GetDocument(context.Background(), 1)
+
+				return err
+			},
+			wantErr: &RequestError{
+				StatusCode: http.StatusForbidden,
+				Message:    `{"detail":"You do not have permission to perform this action."}`,
+			},
+			wantLogins: 1,
+		},
+		{
+			name: "bad credentials",
+			auth: SessionAuth{Username: "user", Password: "wrong"},
+			run: func(t *testing.T, _ *fakeSessionServer, c *Client) error {
+				return c.Ping(context.Background())
+			},
+			wantErr: ErrSessionLogin,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := &fakeSessionServer{
+				prefix:   tc.auth.CookiePrefix,
+				username: "user",
+				password: "secret",
+				sessions: map[string]bool{},
+			}
+
+			srv := httptest.NewServer(fake)
+			t.Cleanup(srv.Close)
+
+			auth := tc.auth
+
+			c := New(Options{
+				BaseURL: srv.URL,
+				Auth:    &auth,
+			})
+
+			err := tc.run(t, fake, c)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Error diff (-want +got):\n%s", diff)
+			}
+
+			if fake.logins != tc.wantLogins {
+				t.Errorf("Got %d logins, want %d", fake.logins, tc.wantLogins)
+			}
+		})
+	}
+}
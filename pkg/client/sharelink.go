@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ShareLinkFileVersion selects the document file made available via a share
+// link.
+type ShareLinkFileVersion string
+
+const (
+	ShareLinkFileVersionArchive  ShareLinkFileVersion = "archive"
+	ShareLinkFileVersionOriginal ShareLinkFileVersion = "original"
+)
+
+// ErrShareLinkUnavailable is returned when a share link doesn't exist or has
+// expired. The server redirects to the login page in both cases.
+var ErrShareLinkUnavailable = errors.New("share link not found or expired")
+
+func (c *Client) shareLinkCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/share_links/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(ShareLink).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListShareLinksOptions).Page = page
+		},
+	}
+}
+
+type ListShareLinksOptions struct {
+	ListOptions
+}
+
+func (c *Client) ListShareLinks(ctx context.Context, opts ListShareLinksOptions) ([]ShareLink, *Response, error) {
+	ctx = withOperation(ctx, "ListShareLinks")
+
+	return crudList[ShareLink](ctx, c.shareLinkCrudOpts(), opts)
+}
+
+// ListAllShareLinks iterates over all share links matching the filters
+// specified in opts, invoking handler for each.
+func (c *Client) ListAllShareLinks(ctx context.Context, opts ListShareLinksOptions, handler func(context.Context, ShareLink) error) error {
+	ctx = withOperation(ctx, "ListAllShareLinks")
+
+	return crudListAll[ShareLink](ctx, c.shareLinkCrudOpts(), opts, handler)
+}
+
+// IterAllShareLinks returns an iterator over all share links matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllShareLinks(ctx context.Context, opts ListShareLinksOptions) iter.Seq2[ShareLink, error] {
+	ctx = withOperation(ctx, "IterAllShareLinks")
+
+	return crudIterAll[ShareLink](ctx, c.shareLinkCrudOpts(), opts)
+}
+
+func (c *Client) GetShareLink(ctx context.Context, id int64) (*ShareLink, *Response, error) {
+	ctx = withOperation(ctx, "GetShareLink")
+
+	return crudGet[ShareLink](ctx, c.shareLinkCrudOpts(), id)
+}
+
+func (c *Client) CreateShareLink(ctx context.Context, data *ShareLinkFields) (*ShareLink, *Response, error) {
+	ctx = withOperation(ctx, "CreateShareLink")
+
+	return crudCreate[ShareLink](ctx, c.shareLinkCrudOpts(), data)
+}
+
+func (c *Client) UpdateShareLink(ctx context.Context, id int64, data *ShareLink) (*ShareLink, *Response, error) {
+	ctx = withOperation(ctx, "UpdateShareLink")
+
+	return crudUpdate[ShareLink](ctx, c.shareLinkCrudOpts(), id, data)
+}
+
+func (c *Client) PatchShareLink(ctx context.Context, id int64, data *ShareLinkFields) (*ShareLink, *Response, error) {
+	ctx = withOperation(ctx, "PatchShareLink")
+
+	return crudPatch[ShareLink](ctx, c.shareLinkCrudOpts(), id, data)
+}
+
+func (c *Client) DeleteShareLink(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteShareLink")
+
+	return crudDelete[ShareLink](ctx, c.shareLinkCrudOpts(), id)
+}
+
+// ListDocumentShareLinks retrieves all share links of a document.
+func (c *Client) ListDocumentShareLinks(ctx context.Context, id int64) ([]ShareLink, *Response, error) {
+	ctx = withOperation(ctx, "ListDocumentShareLinks")
+
+	var result []ShareLink
+
+	resp, err := c.newRequest(ctx).
+		SetResult(&result).
+		Get(fmt.Sprintf("api/documents/%d/share_links/", id))
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	return result, wrapResponse(resp), nil
+}
+
+func shareLinkPath(slug string) string {
+	return "share/" + url.PathEscape(slug)
+}
+
+// ShareLinkURL returns the public URL of a share link.
+func (c *Client) ShareLinkURL(slug string) string {
+	return c.r.BaseURL + "/" + shareLinkPath(slug)
+}
+
+// DownloadShareLink retrieves the file made available by a share link without
+// authenticating. See [Client.DownloadDocumentOriginal] for details.
+func (c *Client) DownloadShareLink(ctx context.Context, w io.Writer, slug string) (*DownloadResult, *Response, error) {
+	ctx = withOperation(ctx, "DownloadShareLink")
+
+	req := c.anon.R().
+		SetContext(ctx).
+		SetError(requestError{})
+
+	result, resp, err := c.downloadRequest(w, req, resty.MethodGet, shareLinkPath(slug), true)
+
+	if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusFound {
+		err = ErrShareLinkUnavailable
+	}
+
+	return result, resp, err
+}
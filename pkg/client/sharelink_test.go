@@ -0,0 +1,192 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestListDocumentShareLinks(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodGet, "/api/documents/17/share_links/",
+		httpmock.NewStringResponder(http.StatusOK, `[
+			{
+				"id": 3,
+				"created": "2024-06-01T09:00:00Z",
+				"expiration": "2024-07-01T09:00:00Z",
+				"slug": "AbC123",
+				"document": 17,
+				"file_version": "original"
+			},
+			{
+				"id": 4,
+				"created": "2024-06-02T09:00:00Z",
+				"expiration": null,
+				"slug": "XyZ789",
+				"document": 17,
+				"file_version": "archive"
+			}
+		]`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.ListDocumentShareLinks(context.Background(), 17)
+	if err != nil {
+		t.Fatalf("ListDocumentShareLinks() failed: %v", err)
+	}
+
+	want := []ShareLink{
+		{
+			ID:          3,
+			Created:     time.Date(2024, time.June, 1, 9, 0, 0, 0, time.UTC),
+			Expiration:  Time(time.Date(2024, time.July, 1, 9, 0, 0, 0, time.UTC)),
+			Slug:        "AbC123",
+			Document:    17,
+			FileVersion: ShareLinkFileVersionOriginal,
+		},
+		{
+			ID:          4,
+			Created:     time.Date(2024, time.June, 2, 9, 0, 0, 0, time.UTC),
+			Slug:        "XyZ789",
+			Document:    17,
+			FileVersion: ShareLinkFileVersionArchive,
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("ListDocumentShareLinks() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestCreateShareLink(t *testing.T) {
+	expiration := time.Date(2024, time.July, 1, 9, 0, 0, 0, time.UTC)
+
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodPost, "/api/share_links/",
+		newJSONBodyResponder(t, map[string]any{
+			"document":     17,
+			"expiration":   expiration,
+			"file_version": "archive",
+		}, httpmock.NewStringResponder(http.StatusCreated, `{"id": 5, "slug": "new", "document": 17}`)))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.CreateShareLink(context.Background(), NewShareLinkFields().
+		SetDocument(17).
+		SetExpiration(&expiration).
+		SetFileVersion(ShareLinkFileVersionArchive))
+	if err != nil {
+		t.Fatalf("CreateShareLink() failed: %v", err)
+	}
+
+	want := &ShareLink{
+		ID:       5,
+		Slug:     "new",
+		Document: 17,
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("CreateShareLink() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestShareLinkURL(t *testing.T) {
+	for _, tc := range []struct {
+		baseURL string
+		slug    string
+		want    string
+	}{
+		{"", "abc", "/share/abc"},
+		{"https://paperless.example.com", "abc", "https://paperless.example.com/share/abc"},
+		{"https://example.com/paperless/", "a/b", "https://example.com/paperless/share/a%2Fb"},
+	} {
+		t.Run(tc.want, func(t *testing.T) {
+			c := New(Options{
+				BaseURL: tc.baseURL,
+			})
+
+			if got := c.ShareLinkURL(tc.slug); got != tc.want {
+				t.Errorf("ShareLinkURL(%q) = %q, want %q", tc.slug, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDownloadShareLink(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		setup   func(*testing.T, *httpmock.MockTransport)
+		slug    string
+		want    *DownloadResult
+		wantErr error
+	}{
+		{
+			name: "success",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/share/AbC123",
+					httpmock.Responder(func(req *http.Request) (*http.Response, error) {
+						if got := req.Header.Get("Authorization"); got != "" {
+							t.Errorf("Share link request has Authorization header %q", got)
+						}
+
+						return httpmock.NewStringResponse(http.StatusOK, "content"), nil
+					}).HeaderSet(http.Header{
+						"Content-Type":        []string{"application/pdf"},
+						"Content-Disposition": []string{`attachment; filename="invoice.pdf"`},
+					}))
+			},
+			slug: "AbC123",
+			want: &DownloadResult{
+				ContentType: "application/pdf",
+				Filename:    "invoice.pdf",
+				Length:      7,
+			},
+		},
+		{
+			name: "expired",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/share/old",
+					httpmock.NewStringResponder(http.StatusFound, "").HeaderSet(http.Header{
+						"Location": []string{"/accounts/login/?sharelink_expired=1"},
+					}))
+			},
+			slug:    "old",
+			wantErr: ErrShareLinkUnavailable,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			tc.setup(t, transport)
+
+			c := New(Options{
+				transport: transport,
+				Auth:      &TokenAuth{Token: "secret"},
+			})
+
+			var buf bytes.Buffer
+
+			got, _, err := c.DownloadShareLink(context.Background(), &buf, tc.slug)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("DownloadShareLink() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("DownloadShareLink() result diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
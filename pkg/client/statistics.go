@@ -22,6 +22,8 @@ type StatisticsDocumentFileType struct {
 }
 
 func (c *Client) GetStatistics(ctx context.Context) (*Statistics, *Response, error) {
+	ctx = withOperation(ctx, "GetStatistics")
+
 	resp, err := c.newRequest(ctx).
 		SetResult(&Statistics{}).
 		Get("api/statistics/")
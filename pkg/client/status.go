@@ -51,6 +51,8 @@ type SystemStatusTasks struct {
 }
 
 func (c *Client) GetStatus(ctx context.Context) (*SystemStatus, *Response, error) {
+	ctx = withOperation(ctx, "GetStatus")
+
 	resp, err := c.newRequest(ctx).
 		SetResult(&SystemStatus{}).
 		Get("api/status/")
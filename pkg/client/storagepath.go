@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 )
 
 func (c *Client) storagePathCrudOpts() crudOptions {
@@ -21,37 +22,60 @@ type ListStoragePathsOptions struct {
 	ListOptions
 
 	Ordering OrderingSpec   `url:"ordering"`
+	ID       IDFilterSpec   `url:"id"`
 	Owner    IntFilterSpec  `url:"owner"`
 	Name     CharFilterSpec `url:"name"`
 	Path     CharFilterSpec `url:"path"`
 }
 
 func (c *Client) ListStoragePaths(ctx context.Context, opts ListStoragePathsOptions) ([]StoragePath, *Response, error) {
+	ctx = withOperation(ctx, "ListStoragePaths")
+
 	return crudList[StoragePath](ctx, c.storagePathCrudOpts(), opts)
 }
 
 // ListAllStoragePaths iterates over all storage paths matching the filters
 // specified in opts, invoking handler for each.
 func (c *Client) ListAllStoragePaths(ctx context.Context, opts ListStoragePathsOptions, handler func(context.Context, StoragePath) error) error {
+	ctx = withOperation(ctx, "ListAllStoragePaths")
+
 	return crudListAll[StoragePath](ctx, c.storagePathCrudOpts(), opts, handler)
 }
 
+// IterAllStoragePaths returns an iterator over all storage paths matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllStoragePaths(ctx context.Context, opts ListStoragePathsOptions) iter.Seq2[StoragePath, error] {
+	ctx = withOperation(ctx, "IterAllStoragePaths")
+
+	return crudIterAll[StoragePath](ctx, c.storagePathCrudOpts(), opts)
+}
+
 func (c *Client) GetStoragePath(ctx context.Context, id int64) (*StoragePath, *Response, error) {
+	ctx = withOperation(ctx, "GetStoragePath")
+
 	return crudGet[StoragePath](ctx, c.storagePathCrudOpts(), id)
 }
 
 func (c *Client) CreateStoragePath(ctx context.Context, data *StoragePathFields) (*StoragePath, *Response, error) {
+	ctx = withOperation(ctx, "CreateStoragePath")
+
 	return crudCreate[StoragePath](ctx, c.storagePathCrudOpts(), data)
 }
 
 func (c *Client) UpdateStoragePath(ctx context.Context, id int64, data *StoragePath) (*StoragePath, *Response, error) {
+	ctx = withOperation(ctx, "UpdateStoragePath")
+
 	return crudUpdate[StoragePath](ctx, c.storagePathCrudOpts(), id, data)
 }
 
 func (c *Client) PatchStoragePath(ctx context.Context, id int64, data *StoragePathFields) (*StoragePath, *Response, error) {
+	ctx = withOperation(ctx, "PatchStoragePath")
+
 	return crudPatch[StoragePath](ctx, c.storagePathCrudOpts(), id, data)
 }
 
 func (c *Client) DeleteStoragePath(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteStoragePath")
+
 	return crudDelete[StoragePath](ctx, c.storagePathCrudOpts(), id)
 }
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DocumentSuggestions contains the objects suggested by the classifier and
+// the dates found in the document content.
+type DocumentSuggestions struct {
+	Correspondents []int64
+	Tags           []int64
+	DocumentTypes  []int64
+	StoragePaths   []int64
+	Dates          []time.Time
+
+	// Suggested objects in the same order as the IDs. Only populated when
+	// requested via [GetDocumentSuggestionsOptions.Resolve]. Objects which
+	// no longer exist or aren't visible to the user are missing.
+	ResolvedCorrespondents []Correspondent
+	ResolvedTags           []Tag
+	ResolvedDocumentTypes  []DocumentType
+	ResolvedStoragePaths   []StoragePath
+}
+
+type documentSuggestionsJSON struct {
+	Correspondents []int64  `json:"correspondents"`
+	Tags           []int64  `json:"tags"`
+	DocumentTypes  []int64  `json:"document_types"`
+	StoragePaths   []int64  `json:"storage_paths"`
+	Dates          []string `json:"dates"`
+}
+
+type GetDocumentSuggestionsOptions struct {
+	// Retrieve the suggested objects with one request per object type.
+	Resolve bool
+}
+
+// resolveIDs retrieves the objects with the given IDs using a single list
+// request (or more if the number of IDs exceeds the page size). The result is
+// in the same order as ids.
+func resolveIDs[T any](ctx context.Context, ids []int64, getID func(T) int64, listAll func(context.Context, IDFilterSpec, func(context.Context, T) error) error) ([]T, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	found := map[int64]T{}
+
+	if err := listAll(ctx, IDFilterSpec{In: ids}, func(_ context.Context, item T) error {
+		found[getID(item)] = item
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var result []T
+
+	for _, id := range ids {
+		if item, ok := found[id]; ok {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *Client) resolveDocumentSuggestions(ctx context.Context, s *DocumentSuggestions) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() (err error) {
+		s.ResolvedCorrespondents, err = resolveIDs(ctx, s.Correspondents,
+			func(v Correspondent) int64 { return v.ID },
+			func(ctx context.Context, spec IDFilterSpec, handler func(context.Context, Correspondent) error) error {
+				return c.ListAllCorrespondents(ctx, ListCorrespondentsOptions{ID: spec}, handler)
+			})
+		return err
+	})
+
+	g.Go(func() (err error) {
+		s.ResolvedTags, err = resolveIDs(ctx, s.Tags,
+			func(v Tag) int64 { return v.ID },
+			func(ctx context.Context, spec IDFilterSpec, handler func(context.Context, Tag) error) error {
+				return c.ListAllTags(ctx, ListTagsOptions{ID: spec}, handler)
+			})
+		return err
+	})
+
+	g.Go(func() (err error) {
+		s.ResolvedDocumentTypes, err = resolveIDs(ctx, s.DocumentTypes,
+			func(v DocumentType) int64 { return v.ID },
+			func(ctx context.Context, spec IDFilterSpec, handler func(context.Context, DocumentType) error) error {
+				return c.ListAllDocumentTypes(ctx, ListDocumentTypesOptions{ID: spec}, handler)
+			})
+		return err
+	})
+
+	g.Go(func() (err error) {
+		s.ResolvedStoragePaths, err = resolveIDs(ctx, s.StoragePaths,
+			func(v StoragePath) int64 { return v.ID },
+			func(ctx context.Context, spec IDFilterSpec, handler func(context.Context, StoragePath) error) error {
+				return c.ListAllStoragePaths(ctx, ListStoragePathsOptions{ID: spec}, handler)
+			})
+		return err
+	})
+
+	return g.Wait()
+}
+
+// GetDocumentSuggestions retrieves the correspondents, tags, document types
+// and storage paths the classifier suggests for a document, as well as dates
+// found in its content.
+func (c *Client) GetDocumentSuggestions(ctx context.Context, id int64, opts GetDocumentSuggestionsOptions) (*DocumentSuggestions, *Response, error) {
+	ctx = withOperation(ctx, "GetDocumentSuggestions")
+
+	resp, err := c.newRequest(ctx).
+		SetResult(&documentSuggestionsJSON{}).
+		Get(fmt.Sprintf("api/documents/%d/suggestions/", id))
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	raw := resp.Result().(*documentSuggestionsJSON)
+
+	result := &DocumentSuggestions{
+		Correspondents: raw.Correspondents,
+		Tags:           raw.Tags,
+		DocumentTypes:  raw.DocumentTypes,
+		StoragePaths:   raw.StoragePaths,
+	}
+
+	for _, i := range raw.Dates {
+		ts, err := parseServerTime(i, c.loc)
+		if err != nil {
+			return nil, wrapResponse(resp), fmt.Errorf("suggested date: %w", err)
+		}
+
+		result.Dates = append(result.Dates, ts)
+	}
+
+	if opts.Resolve {
+		if err := c.resolveDocumentSuggestions(ctx, result); err != nil {
+			return nil, wrapResponse(resp), err
+		}
+	}
+
+	return result, wrapResponse(resp), nil
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestGetDocumentSuggestions(t *testing.T) {
+	const suggestionsJSON = `{
+		"correspondents": [4],
+		"tags": [7, 2],
+		"document_types": [],
+		"storage_paths": [],
+		"dates": ["2024-03-05", "2023-12-31"]
+	}`
+
+	wantDates := []time.Time{
+		time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, tc := range []struct {
+		name    string
+		setup   func(*testing.T, *httpmock.MockTransport)
+		opts    GetDocumentSuggestionsOptions
+		want    *DocumentSuggestions
+		wantErr error
+	}{
+		{
+			name: "ids only",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/33/suggestions/",
+					httpmock.NewStringResponder(http.StatusOK, suggestionsJSON))
+			},
+			want: &DocumentSuggestions{
+				Correspondents: []int64{4},
+				Tags:           []int64{7, 2},
+				Dates:          wantDates,
+			},
+		},
+		{
+			name: "resolve",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/33/suggestions/",
+					httpmock.NewStringResponder(http.StatusOK, suggestionsJSON))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/correspondents/",
+					"page=1&page_size=25&id__in=4",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"results": [{"id": 4, "name": "Utility"}]
+					}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/tags/",
+					"page=1&page_size=25&id__in=7,2",
+					httpmock.NewStringResponder(http.StatusOK, `{
+						"results": [
+							{"id": 2, "name": "paid"},
+							{"id": 7, "name": "invoice"}
+						]
+					}`))
+			},
+			opts: GetDocumentSuggestionsOptions{
+				Resolve: true,
+			},
+			want: &DocumentSuggestions{
+				Correspondents: []int64{4},
+				Tags:           []int64{7, 2},
+				Dates:          wantDates,
+				ResolvedCorrespondents: []Correspondent{
+					{ID: 4, Name: "Utility"},
+				},
+				ResolvedTags: []Tag{
+					{ID: 7, Name: "invoice"},
+					{ID: 2, Name: "paid"},
+				},
+			},
+		},
+		{
+			name: "resolve fails",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/33/suggestions/",
+					httpmock.NewStringResponder(http.StatusOK, `{"tags": [1]}`))
+				transport.RegisterResponder(http.MethodGet, "/api/tags/",
+					httpmock.NewStringResponder(http.StatusForbidden, `{}`))
+			},
+			opts: GetDocumentSuggestionsOptions{
+				Resolve: true,
+			},
+			wantErr: &RequestError{
+				StatusCode: http.StatusForbidden,
+				Message:    `{}`,
+			},
+		},
+		{
+			name: "bad date",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/33/suggestions/",
+					httpmock.NewStringResponder(http.StatusOK, `{"dates": ["March"]}`))
+			},
+			wantErr: cmpopts.AnyError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			tc.setup(t, transport)
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			got, _, err := c.GetDocumentSuggestions(context.Background(), 33, tc.opts)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("GetDocumentSuggestions() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("GetDocumentSuggestions() result diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
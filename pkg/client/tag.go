@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"iter"
 )
 
 func (c *Client) tagCrudOpts() crudOptions {
@@ -21,36 +22,59 @@ type ListTagsOptions struct {
 	ListOptions
 
 	Ordering OrderingSpec   `url:"ordering"`
+	ID       IDFilterSpec   `url:"id"`
 	Owner    IntFilterSpec  `url:"owner"`
 	Name     CharFilterSpec `url:"name"`
 }
 
 func (c *Client) ListTags(ctx context.Context, opts ListTagsOptions) ([]Tag, *Response, error) {
+	ctx = withOperation(ctx, "ListTags")
+
 	return crudList[Tag](ctx, c.tagCrudOpts(), opts)
 }
 
 // ListAllTags iterates over all tags matching the filters specified in opts,
 // invoking handler for each.
 func (c *Client) ListAllTags(ctx context.Context, opts ListTagsOptions, handler func(context.Context, Tag) error) error {
+	ctx = withOperation(ctx, "ListAllTags")
+
 	return crudListAll[Tag](ctx, c.tagCrudOpts(), opts, handler)
 }
 
+// IterAllTags returns an iterator over all tags matching the filters specified
+// in opts. Iteration stops at the first error.
+func (c *Client) IterAllTags(ctx context.Context, opts ListTagsOptions) iter.Seq2[Tag, error] {
+	ctx = withOperation(ctx, "IterAllTags")
+
+	return crudIterAll[Tag](ctx, c.tagCrudOpts(), opts)
+}
+
 func (c *Client) GetTag(ctx context.Context, id int64) (*Tag, *Response, error) {
+	ctx = withOperation(ctx, "GetTag")
+
 	return crudGet[Tag](ctx, c.tagCrudOpts(), id)
 }
 
 func (c *Client) CreateTag(ctx context.Context, data *TagFields) (*Tag, *Response, error) {
+	ctx = withOperation(ctx, "CreateTag")
+
 	return crudCreate[Tag](ctx, c.tagCrudOpts(), data)
 }
 
 func (c *Client) UpdateTag(ctx context.Context, id int64, data *Tag) (*Tag, *Response, error) {
+	ctx = withOperation(ctx, "UpdateTag")
+
 	return crudUpdate[Tag](ctx, c.tagCrudOpts(), id, data)
 }
 
 func (c *Client) PatchTag(ctx context.Context, id int64, data *TagFields) (*Tag, *Response, error) {
+	ctx = withOperation(ctx, "PatchTag")
+
 	return crudPatch[Tag](ctx, c.tagCrudOpts(), id, data)
 }
 
 func (c *Client) DeleteTag(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteTag")
+
 	return crudDelete[Tag](ctx, c.tagCrudOpts(), id)
 }
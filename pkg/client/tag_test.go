@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -306,6 +307,118 @@ func TestListAllTagsHandlerCancelsContext(t *testing.T) {
 	}
 }
 
+func TestIterAllTags(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/tags/",
+		"page=1&page_size=25",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"next": "?page=2",
+			"results": [
+				{ "id": 10, "name": "first" },
+				{ "id": 20, "name": "second" }
+			]
+		}`))
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/tags/",
+		"page=2&page_size=25",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"next": "?page=3",
+			"results": [
+				{ "id": 20, "name": "second" },
+				{ "id": 30, "name": "third" }
+			]
+		}`))
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/tags/",
+		"page=3&page_size=25",
+		httpmock.NewStringResponder(http.StatusInternalServerError, `{}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	var got []Tag
+	var gotErr error
+
+	for tag, err := range c.IterAllTags(context.Background(), ListTagsOptions{}) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+
+		got = append(got, tag)
+	}
+
+	want := []Tag{
+		{ID: 10, Name: "first"},
+		{ID: 20, Name: "second"},
+		{ID: 30, Name: "third"},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("IterAllTags() diff (-want +got):\n%s", diff)
+	}
+
+	var requestErr *RequestError
+
+	if !errors.As(gotErr, &requestErr) || requestErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("IterAllTags() error = %v, want status %d", gotErr, http.StatusInternalServerError)
+	}
+}
+
+func TestIterAllTagsBreak(t *testing.T) {
+	var requests atomic.Int64
+
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodGet, "/api/tags/",
+		httpmock.Responder(func(req *http.Request) (*http.Response, error) {
+			pageNumber, err := strconv.Atoi(req.FormValue("page"))
+			if err != nil {
+				t.Error(err)
+				return nil, err
+			}
+
+			requests.Add(1)
+
+			result := &listResult[Tag]{}
+			result.Next = fmt.Sprintf("?page=%d", pageNumber+1)
+
+			for idx := range 5 {
+				result.Items = append(result.Items, Tag{
+					ID: int64(pageNumber*10 + idx),
+				})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, result)
+		}))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	var count int
+
+	for _, err := range c.IterAllTags(context.Background(), ListTagsOptions{}) {
+		if err != nil {
+			t.Fatalf("IterAllTags() failed: %v", err)
+		}
+
+		if count++; count == 7 {
+			break
+		}
+	}
+
+	// The producer is stopped once the loop is left. At most the queue
+	// capacity plus the page in flight may have been prefetched.
+	fetched := requests.Load()
+
+	if got, want := fetched, int64(5); got > want {
+		t.Errorf("IterAllTags() fetched %d pages, want at most %d", got, want)
+	}
+
+	if got := requests.Load(); got != fetched {
+		t.Errorf("IterAllTags() continued fetching after break: %d != %d", got, fetched)
+	}
+}
+
 func TestGetTag(t *testing.T) {
 	for _, tc := range []struct {
 		name      string
@@ -3,8 +3,12 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -117,6 +121,52 @@ type Task struct {
 	Status       TaskStatus `json:"status"`
 	Result       *string    `json:"result"`
 	Acknowledged bool       `json:"acknowledged"`
+
+	// ID of the document created or modified by the task, if any. Set for
+	// successful consumption tasks.
+	RelatedDocument *int64 `json:"-"`
+}
+
+var _ json.Unmarshaler = (*Task)(nil)
+
+func (t *Task) UnmarshalJSON(data []byte) error {
+	type plain Task
+
+	raw := struct {
+		*plain
+
+		// Reported as a string by the server.
+		RelatedDocument any `json:"related_document"`
+	}{
+		plain: (*plain)(t),
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t.RelatedDocument = nil
+
+	switch value := raw.RelatedDocument.(type) {
+	case nil:
+	case float64:
+		t.RelatedDocument = Int64(int64(value))
+
+	case string:
+		if value != "" {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("related document: %w", err)
+			}
+
+			t.RelatedDocument = &id
+		}
+
+	default:
+		return fmt.Errorf("related document: unsupported value %v", value)
+	}
+
+	return nil
 }
 
 func (t *Task) statusError() error {
@@ -136,19 +186,117 @@ func (t *Task) statusError() error {
 	return err
 }
 
+// ListTasksOptions filters the tasks returned by
+// [Client.ListTasksWithOptions]. The date filters are applied by the client
+// after all tasks have been retrieved; they don't reduce the amount of data
+// fetched from the server.
+type ListTasksOptions struct {
+	// Only include tasks with the given status.
+	Status TaskStatus
+
+	// Only include tasks of the given type, e.g. "auto_task" or
+	// "manual_task".
+	Type string
+
+	// Only include tasks with the given name, e.g. "consume_file".
+	TaskName string
+
+	// Only include acknowledged or unacknowledged tasks.
+	Acknowledged *bool
+
+	// Only include tasks created after the given time. Paperless doesn't
+	// support filtering by date; applied by the client.
+	CreatedAfter *time.Time
+
+	// Only include tasks created before the given time. Applied by the
+	// client.
+	CreatedBefore *time.Time
+}
+
+func (o ListTasksOptions) values() url.Values {
+	values := url.Values{}
+
+	if o.Status != TaskStatusUnspecified {
+		values.Set("status", taskStatusText[o.Status])
+	}
+
+	if o.Type != "" {
+		values.Set("type", o.Type)
+	}
+
+	if o.TaskName != "" {
+		values.Set("task_name", o.TaskName)
+	}
+
+	if o.Acknowledged != nil {
+		values.Set("acknowledged", strconv.FormatBool(*o.Acknowledged))
+	}
+
+	return values
+}
+
+func (o ListTasksOptions) match(t Task) bool {
+	if o.CreatedAfter == nil && o.CreatedBefore == nil {
+		return true
+	}
+
+	if t.Created == nil {
+		return false
+	}
+
+	return !((o.CreatedAfter != nil && !t.Created.After(*o.CreatedAfter)) ||
+		(o.CreatedBefore != nil && !t.Created.Before(*o.CreatedBefore)))
+}
+
+// ListTasks retrieves all tasks.
 func (c *Client) ListTasks(ctx context.Context) ([]Task, *Response, error) {
+	ctx = withOperation(ctx, "ListTasks")
+
+	return c.ListTasksWithOptions(ctx, ListTasksOptions{})
+}
+
+// ListTasksWithOptions retrieves all tasks matching the filters specified in
+// opts. The returned response is the one of the unfiltered server request.
+func (c *Client) ListTasksWithOptions(ctx context.Context, opts ListTasksOptions) ([]Task, *Response, error) {
+	ctx = withOperation(ctx, "ListTasksWithOptions")
+
 	resp, err := c.newRequest(ctx).
 		SetResult([]Task(nil)).
+		SetQueryParamsFromValues(opts.values()).
 		Get("api/tasks/")
 
 	if err := convertError(err, resp); err != nil {
 		return nil, wrapResponse(resp), err
 	}
 
-	return *resp.Result().(*[]Task), wrapResponse(resp), nil
+	tasks := slices.DeleteFunc(*resp.Result().(*[]Task), func(t Task) bool {
+		return !opts.match(t)
+	})
+
+	return tasks, wrapResponse(resp), nil
+}
+
+// AcknowledgeTasks marks tasks as acknowledged, hiding them from the
+// dashboard. Tasks are identified by [Task.ID].
+func (c *Client) AcknowledgeTasks(ctx context.Context, ids []int64) (*Response, error) {
+	ctx = withOperation(ctx, "AcknowledgeTasks")
+
+	if len(ids) == 0 {
+		return nil, errors.New("acknowledging requires at least one task")
+	}
+
+	resp, err := c.newRequest(ctx).
+		SetBody(map[string]any{
+			"tasks": ids,
+		}).
+		Post("api/acknowledge_tasks/")
+
+	return wrapResponse(resp), convertError(err, resp)
 }
 
 func (c *Client) GetTask(ctx context.Context, taskID string) (*Task, *Response, error) {
+	ctx = withOperation(ctx, "GetTask")
+
 	resp, err := c.newRequest(ctx).
 		SetResult([]*Task(nil)).
 		SetQueryParam("task_id", taskID).
@@ -102,6 +102,8 @@ func TestListTasks(t *testing.T) {
 					Status:       TaskSuccess,
 					Result:       String("Success. New document id 26150 created"),
 					Acknowledged: false,
+
+					RelatedDocument: Int64(26150),
 				},
 				{
 					ID:     22,
@@ -109,6 +111,14 @@ func TestListTasks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "bad related document",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/tasks/",
+					httpmock.NewStringResponder(http.StatusOK, `[{ "id": 1, "related_document": "abc" }]`))
+			},
+			wantErr: cmpopts.AnyError,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			transport := newMockTransport(t)
@@ -134,6 +144,86 @@ func TestListTasks(t *testing.T) {
 	}
 }
 
+func TestListTasksWithOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		setup   func(*testing.T, *httpmock.MockTransport)
+		opts    ListTasksOptions
+		wantErr error
+		want    []Task
+	}{
+		{
+			name: "filters",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/tasks/",
+					"status=FAILURE&type=auto_task&task_name=consume_file&acknowledged=false",
+					httpmock.NewStringResponder(http.StatusOK, `[
+						{ "id": 1, "task_id": "old", "date_created": "2023-01-01T00:00:00Z" },
+						{ "id": 2, "task_id": "new", "date_created": "2023-03-01T00:00:00Z", "related_document": 17 },
+						{ "id": 3, "task_id": "unknown" }
+					]`))
+			},
+			opts: ListTasksOptions{
+				Status:       TaskFailure,
+				Type:         "auto_task",
+				TaskName:     "consume_file",
+				Acknowledged: Bool(false),
+				CreatedAfter: Time(time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			want: []Task{
+				{
+					ID:              2,
+					TaskID:          "new",
+					Created:         Time(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)),
+					RelatedDocument: Int64(17),
+				},
+			},
+		},
+		{
+			name: "created before",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/tasks/",
+					httpmock.NewStringResponder(http.StatusOK, `[
+						{ "id": 1, "task_id": "old", "date_created": "2023-01-01T00:00:00Z" },
+						{ "id": 2, "task_id": "new", "date_created": "2023-03-01T00:00:00Z" }
+					]`))
+			},
+			opts: ListTasksOptions{
+				CreatedBefore: Time(time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			want: []Task{
+				{
+					ID:      1,
+					TaskID:  "old",
+					Created: Time(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			tc.setup(t, transport)
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			got, _, err := c.ListTasksWithOptions(context.Background(), tc.opts)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("ListTasksWithOptions() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("ListTasksWithOptions() diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestGetTask(t *testing.T) {
 	for _, tc := range []struct {
 		name    string
@@ -225,3 +315,23 @@ func TestGetTask(t *testing.T) {
 		})
 	}
 }
+
+func TestAcknowledgeTasks(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodPost, "/api/acknowledge_tasks/",
+		newJSONBodyResponder(t, map[string]any{
+			"tasks": []int64{4, 5},
+		}, httpmock.NewStringResponder(http.StatusOK, `{"result": 2}`)))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	if _, err := c.AcknowledgeTasks(context.Background(), []int64{4, 5}); err != nil {
+		t.Errorf("AcknowledgeTasks() failed: %v", err)
+	}
+
+	if _, err := c.AcknowledgeTasks(context.Background(), nil); err == nil {
+		t.Errorf("AcknowledgeTasks() without tasks succeeded")
+	}
+}
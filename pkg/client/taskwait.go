@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -44,6 +45,7 @@ func DefaultWaitForTaskCondition(task *Task) error {
 
 type taskWaiter struct {
 	logger Logger
+	taskID string
 	b      backoff.BackOff
 	get    func(context.Context) (*Task, error)
 	cond   WaitForTaskConditionFunc
@@ -65,7 +67,10 @@ func (w taskWaiter) wait(ctx context.Context) (*Task, error) {
 
 		return task, w.cond(task)
 	}, backoff.WithContext(w.b, ctx), func(err error, delay time.Duration) {
-		w.logger.Debugf("Condition not met, retry in %s: %v", delay.String(), err)
+		logAttrs(w.logger, slog.LevelDebug, "Condition not met, retrying",
+			slog.String("task_id", w.taskID),
+			slog.Duration("delay", delay),
+			slog.Any("error", err))
 	})
 
 	if err == nil {
@@ -79,12 +84,12 @@ func (w taskWaiter) wait(ctx context.Context) (*Task, error) {
 // (success, failure or revoked). Task failures are reported as an error of
 // type [TaskError].
 func (c *Client) WaitForTask(ctx context.Context, taskID string, opts WaitForTaskOptions) (*Task, error) {
+	ctx = withOperation(ctx, "WaitForTask")
+
 	w := taskWaiter{
-		logger: &prefixLogger{
-			wrapped: c.logger,
-			prefix:  fmt.Sprintf("task %s: ", taskID),
-		},
-		b: opts.makeBackOff(),
+		logger: c.logger,
+		taskID: taskID,
+		b:      opts.makeBackOff(),
 		get: func(ctx context.Context) (*Task, error) {
 			task, _, err := c.GetTask(ctx, taskID)
 			return task, err
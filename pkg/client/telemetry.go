@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/hansmi/paperhooks/pkg/client"
+
+const (
+	attrOperation  = attribute.Key("paperless.operation")
+	attrItemCount  = attribute.Key("paperless.item_count")
+	attrMethod     = attribute.Key("http.request.method")
+	attrStatusCode = attribute.Key("http.response.status_code")
+	attrErrorType  = attribute.Key("error.type")
+)
+
+type operationKey struct{}
+
+// withOperation records the name of the API operation on whose behalf
+// requests are made. The outermost operation takes precedence, e.g. for
+// methods implemented using other client methods.
+func withOperation(ctx context.Context, name string) context.Context {
+	if current, _ := ctx.Value(operationKey{}).(string); current != "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, operationKey{}, name)
+}
+
+// withoutOperation returns a context for invoking callbacks which may in turn
+// call client methods.
+func withoutOperation(ctx context.Context) context.Context {
+	if current, _ := ctx.Value(operationKey{}).(string); current == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, operationKey{}, "")
+}
+
+func operationName(ctx context.Context) string {
+	if name, _ := ctx.Value(operationKey{}).(string); name != "" {
+		return name
+	}
+
+	return "unknown"
+}
+
+// itemCounter is implemented by results reporting the total number of items.
+type itemCounter interface {
+	itemCount() (int64, bool)
+}
+
+type requestTelemetryKey struct{}
+
+type requestTelemetry struct {
+	span  trace.Span
+	start time.Time
+	attrs []attribute.KeyValue
+}
+
+type telemetry struct {
+	logger   Logger
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// newTelemetry returns the OpenTelemetry instrumentation for the configured
+// providers or nil if neither is set.
+func newTelemetry(opts Options) *telemetry {
+	if opts.TracerProvider == nil && opts.MeterProvider == nil {
+		return nil
+	}
+
+	tp := opts.TracerProvider
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+
+	mp := opts.MeterProvider
+	if mp == nil {
+		mp = metricnoop.NewMeterProvider()
+	}
+
+	meter := mp.Meter(instrumentationName)
+
+	t := &telemetry{
+		logger: opts.Logger,
+		tracer: tp.Tracer(instrumentationName),
+	}
+
+	var err error
+
+	// Instruments are usable even when an error is reported.
+	if t.duration, err = meter.Float64Histogram("paperless.client.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of Paperless API requests."),
+	); err != nil {
+		t.logger.Warnf("Creating request duration histogram: %v", err)
+	}
+
+	if t.errors, err = meter.Int64Counter("paperless.client.request.errors",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Number of failed Paperless API requests."),
+	); err != nil {
+		t.logger.Warnf("Creating request error counter: %v", err)
+	}
+
+	return t
+}
+
+func (t *telemetry) register(r *resty.Client) {
+	r.OnBeforeRequest(t.before)
+	r.OnSuccess(func(_ *resty.Client, resp *resty.Response) {
+		t.after(resp.Request, resp, nil)
+	})
+	r.OnError(func(req *resty.Request, err error) {
+		var resp *resty.Response
+
+		if respErr, ok := err.(*resty.ResponseError); ok {
+			resp = respErr.Response
+			err = respErr.Err
+		}
+
+		t.after(req, resp, err)
+	})
+}
+
+func (t *telemetry) before(_ *resty.Client, req *resty.Request) error {
+	ctx := req.Context()
+
+	state := &requestTelemetry{
+		start: time.Now(),
+		attrs: []attribute.KeyValue{
+			attrOperation.String(operationName(ctx)),
+			attrMethod.String(req.Method),
+		},
+	}
+
+	ctx, state.span = t.tracer.Start(ctx, state.attrs[0].Value.AsString(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(state.attrs...))
+
+	req.SetContext(context.WithValue(ctx, requestTelemetryKey{}, state))
+
+	return nil
+}
+
+func (t *telemetry) after(req *resty.Request, resp *resty.Response, err error) {
+	if req == nil {
+		return
+	}
+
+	ctx := req.Context()
+
+	state, ok := ctx.Value(requestTelemetryKey{}).(*requestTelemetry)
+	if !ok {
+		return
+	}
+
+	span := state.span
+	attrs := state.attrs
+	errorType := ""
+
+	if resp != nil && resp.RawResponse != nil {
+		attrs = append(attrs, attrStatusCode.Int(resp.StatusCode()))
+
+		span.SetAttributes(attrStatusCode.Int(resp.StatusCode()))
+
+		if resp.StatusCode() >= 400 {
+			errorType = strconv.Itoa(resp.StatusCode())
+			span.SetStatus(codes.Error, resp.Status())
+		} else if counter, ok := resp.Result().(itemCounter); ok {
+			if count, ok := counter.itemCount(); ok {
+				span.SetAttributes(attrItemCount.Int64(count))
+			}
+		}
+	}
+
+	if err != nil {
+		errorType = fmt.Sprintf("%T", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+
+	t.duration.Record(ctx, time.Since(state.start).Seconds(), metric.WithAttributes(attrs...))
+
+	if errorType != "" {
+		t.errors.Add(ctx, 1, metric.WithAttributes(append(attrs, attrErrorType.String(errorType))...))
+	}
+}
@@ -0,0 +1,286 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type recordedSpan struct {
+	Name       string
+	Status     codes.Code
+	Attributes map[attribute.Key]any
+	Errors     int
+}
+
+func summarizeSpans(spans []sdktrace.ReadOnlySpan) []recordedSpan {
+	var result []recordedSpan
+
+	for _, s := range spans {
+		r := recordedSpan{
+			Name:       s.Name(),
+			Status:     s.Status().Code,
+			Attributes: map[attribute.Key]any{},
+		}
+
+		for _, kv := range s.Attributes() {
+			r.Attributes[kv.Key] = kv.Value.AsInterface()
+		}
+
+		for _, e := range s.Events() {
+			if e.Name == "exception" {
+				r.Errors++
+			}
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// collectSums returns the number of recorded durations and the sum of errors.
+func collectSums(t *testing.T, reader sdkmetric.Reader) (int64, int64) {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() failed: %v", err)
+	}
+
+	var durations, errors int64
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					durations += int64(dp.Count)
+				}
+
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					errors += dp.Value
+				}
+			}
+		}
+	}
+
+	return durations, errors
+}
+
+func TestTelemetry(t *testing.T) {
+	errNetwork := errors.New("connection refused")
+
+	for _, tc := range []struct {
+		name          string
+		setup         func(*testing.T, *httpmock.MockTransport)
+		call          func(context.Context, *Client) error
+		want          []recordedSpan
+		wantDurations int64
+		wantErrors    int64
+	}{
+		{
+			name: "list",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/tags/",
+					httpmock.NewStringResponder(http.StatusOK, `{"count": 42, "results": [{"id": 1}]}`))
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.ListTags(ctx, ListTagsOptions{})
+				return err
+			},
+			want: []recordedSpan{{
+				Name: "ListTags",
+				Attributes: map[attribute.Key]any{
+					attrOperation:  "ListTags",
+					attrMethod:     "GET",
+					attrStatusCode: int64(http.StatusOK),
+					attrItemCount:  int64(42),
+				},
+			}},
+			wantDurations: 1,
+		},
+		{
+			name: "list all",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/tags/",
+					"page=1&page_size=25",
+					httpmock.NewStringResponder(http.StatusOK, `{"count": 2, "next": "?page=2", "results": [{"id": 1}]}`))
+				transport.RegisterResponderWithQuery(http.MethodGet, "/api/tags/",
+					"page=2&page_size=25",
+					httpmock.NewStringResponder(http.StatusOK, `{"count": 2, "results": [{"id": 2}]}`))
+			},
+			call: func(ctx context.Context, c *Client) error {
+				return c.ListAllTags(ctx, ListTagsOptions{}, func(context.Context, Tag) error {
+					return nil
+				})
+			},
+			want: []recordedSpan{
+				{
+					Name: "ListAllTags",
+					Attributes: map[attribute.Key]any{
+						attrOperation:  "ListAllTags",
+						attrMethod:     "GET",
+						attrStatusCode: int64(http.StatusOK),
+						attrItemCount:  int64(2),
+					},
+				},
+				{
+					Name: "ListAllTags",
+					Attributes: map[attribute.Key]any{
+						attrOperation:  "ListAllTags",
+						attrMethod:     "GET",
+						attrStatusCode: int64(http.StatusOK),
+						attrItemCount:  int64(2),
+					},
+				},
+			},
+			wantDurations: 2,
+		},
+		{
+			name: "wrapper method",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/documents/bulk_edit/",
+					httpmock.NewStringResponder(http.StatusOK, `{"result": "OK"}`))
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.MergeDocuments(ctx, []int64{1, 2}, BulkEditMerge{})
+				return err
+			},
+			want: []recordedSpan{{
+				Name: "MergeDocuments",
+				Attributes: map[attribute.Key]any{
+					attrOperation:  "MergeDocuments",
+					attrMethod:     "POST",
+					attrStatusCode: int64(http.StatusOK),
+				},
+			}},
+			wantDurations: 1,
+		},
+		{
+			name: "call from handler",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/tags/",
+					httpmock.NewStringResponder(http.StatusOK, `{"count": 1, "results": [{"id": 1}]}`))
+				transport.RegisterResponder(http.MethodGet, "/api/tags/1/",
+					httpmock.NewStringResponder(http.StatusOK, `{"id": 1}`))
+			},
+			call: func(ctx context.Context, c *Client) error {
+				return c.ListAllTags(ctx, ListTagsOptions{}, func(ctx context.Context, tag Tag) error {
+					_, _, err := c.GetTag(ctx, tag.ID)
+					return err
+				})
+			},
+			want: []recordedSpan{
+				{
+					Name: "ListAllTags",
+					Attributes: map[attribute.Key]any{
+						attrOperation:  "ListAllTags",
+						attrMethod:     "GET",
+						attrStatusCode: int64(http.StatusOK),
+						attrItemCount:  int64(1),
+					},
+				},
+				{
+					Name: "GetTag",
+					Attributes: map[attribute.Key]any{
+						attrOperation:  "GetTag",
+						attrMethod:     "GET",
+						attrStatusCode: int64(http.StatusOK),
+					},
+				},
+			},
+			wantDurations: 2,
+		},
+		{
+			name: "not found",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodDelete, "/api/tags/7/",
+					httpmock.NewStringResponder(http.StatusNotFound, `{}`))
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.DeleteTag(ctx, 7)
+				return err
+			},
+			want: []recordedSpan{{
+				Name:   "DeleteTag",
+				Status: codes.Error,
+				Attributes: map[attribute.Key]any{
+					attrOperation:  "DeleteTag",
+					attrMethod:     "DELETE",
+					attrStatusCode: int64(http.StatusNotFound),
+				},
+			}},
+			wantDurations: 1,
+			wantErrors:    1,
+		},
+		{
+			name: "network error",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodGet, "/api/documents/3/",
+					httpmock.NewErrorResponder(errNetwork))
+			},
+			call: func(ctx context.Context, c *Client) error {
+				_, _, err := c.GetDocument(ctx, 3)
+				return err
+			},
+			want: []recordedSpan{{
+				Name:   "GetDocument",
+				Status: codes.Error,
+				Attributes: map[attribute.Key]any{
+					attrOperation: "GetDocument",
+					attrMethod:    "GET",
+				},
+				Errors: 1,
+			}},
+			wantDurations: 1,
+			wantErrors:    1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			tc.setup(t, transport)
+
+			recorder := tracetest.NewSpanRecorder()
+			reader := sdkmetric.NewManualReader()
+
+			c := New(Options{
+				transport:      transport,
+				TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+				MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+			})
+
+			if err := tc.call(context.Background(), c); err != nil && tc.wantErrors == 0 {
+				t.Errorf("Call failed: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, summarizeSpans(recorder.Ended()), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Span diff (-want +got):\n%s", diff)
+			}
+
+			durations, errors := collectSums(t, reader)
+
+			if durations != tc.wantDurations {
+				t.Errorf("Recorded %d durations, want %d", durations, tc.wantDurations)
+			}
+
+			if errors != tc.wantErrors {
+				t.Errorf("Recorded %d errors, want %d", errors, tc.wantErrors)
+			}
+		})
+	}
+}
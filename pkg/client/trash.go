@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+func (c *Client) trashCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/trash/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(Document).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListTrashOptions).Page = page
+		},
+	}
+}
+
+type ListTrashOptions struct {
+	ListOptions
+}
+
+// ListTrash retrieves documents in the trash. [Document.DeletedAt] is set on
+// all returned documents.
+func (c *Client) ListTrash(ctx context.Context, opts ListTrashOptions) ([]Document, *Response, error) {
+	ctx = withOperation(ctx, "ListTrash")
+
+	return crudList[Document](ctx, c.trashCrudOpts(), opts)
+}
+
+// ListAllTrash iterates over all documents in the trash, invoking handler for
+// each.
+func (c *Client) ListAllTrash(ctx context.Context, opts ListTrashOptions, handler func(context.Context, Document) error) error {
+	ctx = withOperation(ctx, "ListAllTrash")
+
+	return crudListAll[Document](ctx, c.trashCrudOpts(), opts, handler)
+}
+
+// IterAllTrash returns an iterator over all documents in the trash. Iteration
+// stops at the first error.
+func (c *Client) IterAllTrash(ctx context.Context, opts ListTrashOptions) iter.Seq2[Document, error] {
+	ctx = withOperation(ctx, "IterAllTrash")
+
+	return crudIterAll[Document](ctx, c.trashCrudOpts(), opts)
+}
+
+type trashActionResult struct {
+	Result string  `json:"result"`
+	DocIDs []int64 `json:"doc_ids"`
+}
+
+func (c *Client) trashAction(ctx context.Context, action string, documents []int64) ([]int64, *Response, error) {
+	body := map[string]any{
+		"action": action,
+	}
+
+	if documents != nil {
+		body["documents"] = documents
+	}
+
+	resp, err := c.newRequest(ctx).
+		SetBody(body).
+		SetResult(&trashActionResult{}).
+		Post("api/trash/")
+
+	if err := convertError(err, resp); err != nil {
+		return nil, wrapResponse(resp), err
+	}
+
+	return resp.Result().(*trashActionResult).DocIDs, wrapResponse(resp), nil
+}
+
+// RestoreFromTrash moves documents out of the trash. The IDs of the restored
+// documents are returned.
+func (c *Client) RestoreFromTrash(ctx context.Context, documents []int64) ([]int64, *Response, error) {
+	ctx = withOperation(ctx, "RestoreFromTrash")
+
+	if len(documents) == 0 {
+		return nil, nil, errors.New("restoring from trash requires at least one document")
+	}
+
+	return c.trashAction(ctx, "restore", documents)
+}
+
+// EmptyTrash permanently deletes documents in the trash. All documents in the
+// trash are deleted if documents is empty. The IDs of the deleted documents
+// are returned.
+func (c *Client) EmptyTrash(ctx context.Context, documents []int64) ([]int64, *Response, error) {
+	ctx = withOperation(ctx, "EmptyTrash")
+
+	if len(documents) == 0 {
+		documents = nil
+	}
+
+	return c.trashAction(ctx, "empty", documents)
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestListAllTrash(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/trash/",
+		"page=1&page_size=25",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"count": 2,
+			"next": "?page=2",
+			"results": [
+				{ "id": 4, "title": "Old scan", "deleted_at": "2024-05-01T10:00:00Z" }
+			]
+		}`))
+	transport.RegisterResponderWithQuery(http.MethodGet, "/api/trash/",
+		"page=2&page_size=25",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"count": 2,
+			"results": [
+				{ "id": 9, "title": "Duplicate", "deleted_at": "2024-05-02T08:30:00+02:00" }
+			]
+		}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	var got []Document
+
+	if err := c.ListAllTrash(context.Background(), ListTrashOptions{}, func(_ context.Context, d Document) error {
+		got = append(got, d)
+		return nil
+	}); err != nil {
+		t.Fatalf("ListAllTrash() failed: %v", err)
+	}
+
+	want := []Document{
+		{
+			ID:        4,
+			Title:     "Old scan",
+			DeletedAt: Time(time.Date(2024, time.May, 1, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			ID:        9,
+			Title:     "Duplicate",
+			DeletedAt: Time(time.Date(2024, time.May, 2, 6, 30, 0, 0, time.UTC)),
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty(), cmpopts.EquateApproxTime(0)); diff != "" {
+		t.Errorf("ListAllTrash() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestTrashActions(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		setup   func(*testing.T, *httpmock.MockTransport)
+		call    func(context.Context, *Client) ([]int64, *Response, error)
+		want    []int64
+		wantErr error
+	}{
+		{
+			name: "restore",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/trash/",
+					newJSONBodyResponder(t, map[string]any{
+						"action":    "restore",
+						"documents": []int64{4, 9},
+					}, httpmock.NewStringResponder(http.StatusOK, `{"result": "OK", "doc_ids": [4, 9]}`)))
+			},
+			call: func(ctx context.Context, c *Client) ([]int64, *Response, error) {
+				return c.RestoreFromTrash(ctx, []int64{4, 9})
+			},
+			want: []int64{4, 9},
+		},
+		{
+			name: "restore without documents",
+			call: func(ctx context.Context, c *Client) ([]int64, *Response, error) {
+				return c.RestoreFromTrash(ctx, nil)
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			name: "empty selected",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/trash/",
+					newJSONBodyResponder(t, map[string]any{
+						"action":    "empty",
+						"documents": []int64{9},
+					}, httpmock.NewStringResponder(http.StatusOK, `{"result": "OK", "doc_ids": [9]}`)))
+			},
+			call: func(ctx context.Context, c *Client) ([]int64, *Response, error) {
+				return c.EmptyTrash(ctx, []int64{9})
+			},
+			want: []int64{9},
+		},
+		{
+			name: "empty all",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/trash/",
+					newJSONBodyResponder(t, map[string]any{
+						"action": "empty",
+					}, httpmock.NewStringResponder(http.StatusOK, `{"result": "OK", "doc_ids": [1, 2, 3]}`)))
+			},
+			call: func(ctx context.Context, c *Client) ([]int64, *Response, error) {
+				return c.EmptyTrash(ctx, []int64{})
+			},
+			want: []int64{1, 2, 3},
+		},
+		{
+			name: "error",
+			setup: func(t *testing.T, transport *httpmock.MockTransport) {
+				transport.RegisterResponder(http.MethodPost, "/api/trash/",
+					httpmock.NewStringResponder(http.StatusBadRequest, `{"documents": ["Some documents are not in the trash."]}`))
+			},
+			call: func(ctx context.Context, c *Client) ([]int64, *Response, error) {
+				return c.RestoreFromTrash(ctx, []int64{100})
+			},
+			wantErr: &RequestError{
+				StatusCode: http.StatusBadRequest,
+				Message:    `{"documents":["Some documents are not in the trash."]}`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+
+			if tc.setup != nil {
+				tc.setup(t, transport)
+			}
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			got, _, err := tc.call(context.Background(), c)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Trash error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Trash diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"errors"
+	"iter"
 )
 
 func (c *Client) userCrudOpts() crudOptions {
@@ -26,21 +27,37 @@ type ListUsersOptions struct {
 }
 
 func (c *Client) ListUsers(ctx context.Context, opts ListUsersOptions) ([]User, *Response, error) {
+	ctx = withOperation(ctx, "ListUsers")
+
 	return crudList[User](ctx, c.userCrudOpts(), opts)
 }
 
 // ListAllUsers iterates over all users matching the filters specified in opts,
 // invoking handler for each.
 func (c *Client) ListAllUsers(ctx context.Context, opts ListUsersOptions, handler func(context.Context, User) error) error {
+	ctx = withOperation(ctx, "ListAllUsers")
+
 	return crudListAll[User](ctx, c.userCrudOpts(), opts, handler)
 }
 
+// IterAllUsers returns an iterator over all users matching the filters
+// specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllUsers(ctx context.Context, opts ListUsersOptions) iter.Seq2[User, error] {
+	ctx = withOperation(ctx, "IterAllUsers")
+
+	return crudIterAll[User](ctx, c.userCrudOpts(), opts)
+}
+
 func (c *Client) GetUser(ctx context.Context, id int64) (*User, *Response, error) {
+	ctx = withOperation(ctx, "GetUser")
+
 	return crudGet[User](ctx, c.userCrudOpts(), id)
 }
 
 // GetCurrentUser looks up the authenticated user.
 func (c *Client) GetCurrentUser(ctx context.Context) (*User, *Response, error) {
+	ctx = withOperation(ctx, "GetCurrentUser")
+
 	type uiSettings struct {
 		User struct {
 			ID *int64 `json:"id"`
@@ -0,0 +1,386 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=WorkflowTriggerType,WorkflowTriggerSource,WorkflowActionType -output=workflow_string.go
+type WorkflowTriggerType int
+
+const (
+	WorkflowTriggerTypeUnspecified WorkflowTriggerType = iota
+
+	// Consumption of a document has started.
+	WorkflowTriggerTypeConsumption
+
+	// A document has been added.
+	WorkflowTriggerTypeDocumentAdded
+
+	// A document has been updated.
+	WorkflowTriggerTypeDocumentUpdated
+
+	// Scheduled relative to a date field of documents.
+	WorkflowTriggerTypeScheduled
+)
+
+type WorkflowTriggerSource int
+
+const (
+	WorkflowTriggerSourceUnspecified WorkflowTriggerSource = iota
+
+	// Document found in the consumption folder.
+	WorkflowTriggerSourceConsumeFolder
+
+	// Document uploaded via the API.
+	WorkflowTriggerSourceAPIUpload
+
+	// Document fetched by a mail rule.
+	WorkflowTriggerSourceMailFetch
+)
+
+var _ json.Unmarshaler = (*WorkflowTriggerSource)(nil)
+
+// UnmarshalJSON accepts numbers as well as strings containing a number.
+// Depending on the version Paperless reports sources as strings.
+func (s *WorkflowTriggerSource) UnmarshalJSON(data []byte) error {
+	var value json.Number
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	num, err := strconv.ParseInt(value.String(), 10, 0)
+	if err != nil {
+		return fmt.Errorf("unrecognized workflow trigger source %q: %w", value, err)
+	}
+
+	*s = WorkflowTriggerSource(num)
+
+	return nil
+}
+
+// WorkflowTriggerScheduleDateField is the date field used by scheduled
+// triggers.
+type WorkflowTriggerScheduleDateField string
+
+const (
+	WorkflowScheduleDateAdded       WorkflowTriggerScheduleDateField = "added"
+	WorkflowScheduleDateCreated     WorkflowTriggerScheduleDateField = "created"
+	WorkflowScheduleDateModified    WorkflowTriggerScheduleDateField = "modified"
+	WorkflowScheduleDateCustomField WorkflowTriggerScheduleDateField = "custom_field"
+)
+
+var _ json.Marshaler = (*WorkflowTrigger)(nil)
+
+// MarshalJSON fills in the server defaults for unset fields. Paperless
+// rejects null for multi-value fields, an empty schedule date field and
+// a recurring interval below one day.
+func (t WorkflowTrigger) MarshalJSON() ([]byte, error) {
+	type plain WorkflowTrigger
+
+	p := plain(t)
+	p.Sources = nonNilSlice(p.Sources)
+	p.FilterHasTags = nonNilSlice(p.FilterHasTags)
+
+	if p.ScheduleRecurringIntervalDays == 0 {
+		p.ScheduleRecurringIntervalDays = 1
+	}
+
+	if p.ScheduleDateField == "" {
+		p.ScheduleDateField = WorkflowScheduleDateAdded
+	}
+
+	return json.Marshal(p)
+}
+
+type WorkflowActionType int
+
+const (
+	WorkflowActionTypeUnspecified WorkflowActionType = iota
+
+	// Assign metadata and permissions.
+	WorkflowActionTypeAssignment
+
+	// Remove metadata and permissions.
+	WorkflowActionTypeRemoval
+
+	// Send an email.
+	WorkflowActionTypeEmail
+
+	// Send a webhook request.
+	WorkflowActionTypeWebhook
+)
+
+// WorkflowActionEmail contains the settings for [WorkflowActionTypeEmail]
+// actions.
+type WorkflowActionEmail struct {
+	ID              int64  `json:"id,omitempty"`
+	Subject         string `json:"subject"`
+	Body            string `json:"body"`
+	To              string `json:"to"`
+	IncludeDocument bool   `json:"include_document"`
+}
+
+// WorkflowActionWebhook contains the settings for [WorkflowActionTypeWebhook]
+// actions.
+type WorkflowActionWebhook struct {
+	ID  int64  `json:"id,omitempty"`
+	URL string `json:"url"`
+
+	// Send params as the request body instead of Body.
+	UseParams bool `json:"use_params"`
+
+	// Send params as JSON instead of form-encoded.
+	AsJSON bool `json:"as_json"`
+
+	Params          map[string]string `json:"params"`
+	Body            string            `json:"body"`
+	Headers         map[string]string `json:"headers"`
+	IncludeDocument bool              `json:"include_document"`
+}
+
+var _ json.Marshaler = (*WorkflowAction)(nil)
+
+// MarshalJSON sends empty lists instead of null for unset multi-value fields
+// as Paperless rejects the latter.
+func (a WorkflowAction) MarshalJSON() ([]byte, error) {
+	type plain WorkflowAction
+
+	p := plain(a)
+
+	for _, s := range []*[]int64{
+		&p.AssignTags,
+		&p.AssignViewUsers,
+		&p.AssignViewGroups,
+		&p.AssignChangeUsers,
+		&p.AssignChangeGroups,
+		&p.AssignCustomFields,
+		&p.RemoveTags,
+		&p.RemoveCorrespondents,
+		&p.RemoveDocumentTypes,
+		&p.RemoveStoragePaths,
+		&p.RemoveCustomFields,
+		&p.RemoveOwners,
+		&p.RemoveViewUsers,
+		&p.RemoveViewGroups,
+		&p.RemoveChangeUsers,
+		&p.RemoveChangeGroups,
+	} {
+		*s = nonNilSlice(*s)
+	}
+
+	return json.Marshal(p)
+}
+
+func (c *Client) workflowCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/workflows/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(Workflow).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListWorkflowsOptions).Page = page
+		},
+	}
+}
+
+type ListWorkflowsOptions struct {
+	ListOptions
+}
+
+func (c *Client) ListWorkflows(ctx context.Context, opts ListWorkflowsOptions) ([]Workflow, *Response, error) {
+	ctx = withOperation(ctx, "ListWorkflows")
+
+	return crudList[Workflow](ctx, c.workflowCrudOpts(), opts)
+}
+
+// ListAllWorkflows iterates over all workflows matching the filters specified
+// in opts, invoking handler for each.
+func (c *Client) ListAllWorkflows(ctx context.Context, opts ListWorkflowsOptions, handler func(context.Context, Workflow) error) error {
+	ctx = withOperation(ctx, "ListAllWorkflows")
+
+	return crudListAll[Workflow](ctx, c.workflowCrudOpts(), opts, handler)
+}
+
+// IterAllWorkflows returns an iterator over all workflows matching the
+// filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllWorkflows(ctx context.Context, opts ListWorkflowsOptions) iter.Seq2[Workflow, error] {
+	ctx = withOperation(ctx, "IterAllWorkflows")
+
+	return crudIterAll[Workflow](ctx, c.workflowCrudOpts(), opts)
+}
+
+func (c *Client) GetWorkflow(ctx context.Context, id int64) (*Workflow, *Response, error) {
+	ctx = withOperation(ctx, "GetWorkflow")
+
+	return crudGet[Workflow](ctx, c.workflowCrudOpts(), id)
+}
+
+func (c *Client) CreateWorkflow(ctx context.Context, data *WorkflowFields) (*Workflow, *Response, error) {
+	ctx = withOperation(ctx, "CreateWorkflow")
+
+	return crudCreate[Workflow](ctx, c.workflowCrudOpts(), data)
+}
+
+// UpdateWorkflow replaces a workflow including its triggers and actions.
+// Nested triggers and actions without an ID are created.
+func (c *Client) UpdateWorkflow(ctx context.Context, id int64, data *Workflow) (*Workflow, *Response, error) {
+	ctx = withOperation(ctx, "UpdateWorkflow")
+
+	return crudUpdate[Workflow](ctx, c.workflowCrudOpts(), id, data)
+}
+
+func (c *Client) PatchWorkflow(ctx context.Context, id int64, data *WorkflowFields) (*Workflow, *Response, error) {
+	ctx = withOperation(ctx, "PatchWorkflow")
+
+	return crudPatch[Workflow](ctx, c.workflowCrudOpts(), id, data)
+}
+
+func (c *Client) DeleteWorkflow(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteWorkflow")
+
+	return crudDelete[Workflow](ctx, c.workflowCrudOpts(), id)
+}
+
+func (c *Client) workflowTriggerCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/workflow_triggers/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(WorkflowTrigger).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListWorkflowTriggersOptions).Page = page
+		},
+	}
+}
+
+type ListWorkflowTriggersOptions struct {
+	ListOptions
+}
+
+func (c *Client) ListWorkflowTriggers(ctx context.Context, opts ListWorkflowTriggersOptions) ([]WorkflowTrigger, *Response, error) {
+	ctx = withOperation(ctx, "ListWorkflowTriggers")
+
+	return crudList[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), opts)
+}
+
+// ListAllWorkflowTriggers iterates over all workflow triggers matching the
+// filters specified in opts, invoking handler for each.
+func (c *Client) ListAllWorkflowTriggers(ctx context.Context, opts ListWorkflowTriggersOptions, handler func(context.Context, WorkflowTrigger) error) error {
+	ctx = withOperation(ctx, "ListAllWorkflowTriggers")
+
+	return crudListAll[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), opts, handler)
+}
+
+// IterAllWorkflowTriggers returns an iterator over all workflow triggers
+// matching the filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllWorkflowTriggers(ctx context.Context, opts ListWorkflowTriggersOptions) iter.Seq2[WorkflowTrigger, error] {
+	ctx = withOperation(ctx, "IterAllWorkflowTriggers")
+
+	return crudIterAll[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), opts)
+}
+
+func (c *Client) GetWorkflowTrigger(ctx context.Context, id int64) (*WorkflowTrigger, *Response, error) {
+	ctx = withOperation(ctx, "GetWorkflowTrigger")
+
+	return crudGet[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), id)
+}
+
+func (c *Client) CreateWorkflowTrigger(ctx context.Context, data *WorkflowTriggerFields) (*WorkflowTrigger, *Response, error) {
+	ctx = withOperation(ctx, "CreateWorkflowTrigger")
+
+	return crudCreate[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), data)
+}
+
+func (c *Client) UpdateWorkflowTrigger(ctx context.Context, id int64, data *WorkflowTrigger) (*WorkflowTrigger, *Response, error) {
+	ctx = withOperation(ctx, "UpdateWorkflowTrigger")
+
+	return crudUpdate[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), id, data)
+}
+
+func (c *Client) PatchWorkflowTrigger(ctx context.Context, id int64, data *WorkflowTriggerFields) (*WorkflowTrigger, *Response, error) {
+	ctx = withOperation(ctx, "PatchWorkflowTrigger")
+
+	return crudPatch[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), id, data)
+}
+
+func (c *Client) DeleteWorkflowTrigger(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteWorkflowTrigger")
+
+	return crudDelete[WorkflowTrigger](ctx, c.workflowTriggerCrudOpts(), id)
+}
+
+func (c *Client) workflowActionCrudOpts() crudOptions {
+	return crudOptions{
+		base:       "api/workflow_actions/",
+		newRequest: c.newRequest,
+		getID: func(v any) int64 {
+			return v.(WorkflowAction).ID
+		},
+		setPage: func(opts any, page *PageToken) {
+			opts.(*ListWorkflowActionsOptions).Page = page
+		},
+	}
+}
+
+type ListWorkflowActionsOptions struct {
+	ListOptions
+}
+
+func (c *Client) ListWorkflowActions(ctx context.Context, opts ListWorkflowActionsOptions) ([]WorkflowAction, *Response, error) {
+	ctx = withOperation(ctx, "ListWorkflowActions")
+
+	return crudList[WorkflowAction](ctx, c.workflowActionCrudOpts(), opts)
+}
+
+// ListAllWorkflowActions iterates over all workflow actions matching the
+// filters specified in opts, invoking handler for each.
+func (c *Client) ListAllWorkflowActions(ctx context.Context, opts ListWorkflowActionsOptions, handler func(context.Context, WorkflowAction) error) error {
+	ctx = withOperation(ctx, "ListAllWorkflowActions")
+
+	return crudListAll[WorkflowAction](ctx, c.workflowActionCrudOpts(), opts, handler)
+}
+
+// IterAllWorkflowActions returns an iterator over all workflow actions
+// matching the filters specified in opts. Iteration stops at the first error.
+func (c *Client) IterAllWorkflowActions(ctx context.Context, opts ListWorkflowActionsOptions) iter.Seq2[WorkflowAction, error] {
+	ctx = withOperation(ctx, "IterAllWorkflowActions")
+
+	return crudIterAll[WorkflowAction](ctx, c.workflowActionCrudOpts(), opts)
+}
+
+func (c *Client) GetWorkflowAction(ctx context.Context, id int64) (*WorkflowAction, *Response, error) {
+	ctx = withOperation(ctx, "GetWorkflowAction")
+
+	return crudGet[WorkflowAction](ctx, c.workflowActionCrudOpts(), id)
+}
+
+func (c *Client) CreateWorkflowAction(ctx context.Context, data *WorkflowActionFields) (*WorkflowAction, *Response, error) {
+	ctx = withOperation(ctx, "CreateWorkflowAction")
+
+	return crudCreate[WorkflowAction](ctx, c.workflowActionCrudOpts(), data)
+}
+
+func (c *Client) UpdateWorkflowAction(ctx context.Context, id int64, data *WorkflowAction) (*WorkflowAction, *Response, error) {
+	ctx = withOperation(ctx, "UpdateWorkflowAction")
+
+	return crudUpdate[WorkflowAction](ctx, c.workflowActionCrudOpts(), id, data)
+}
+
+func (c *Client) PatchWorkflowAction(ctx context.Context, id int64, data *WorkflowActionFields) (*WorkflowAction, *Response, error) {
+	ctx = withOperation(ctx, "PatchWorkflowAction")
+
+	return crudPatch[WorkflowAction](ctx, c.workflowActionCrudOpts(), id, data)
+}
+
+func (c *Client) DeleteWorkflowAction(ctx context.Context, id int64) (*Response, error) {
+	ctx = withOperation(ctx, "DeleteWorkflowAction")
+
+	return crudDelete[WorkflowAction](ctx, c.workflowActionCrudOpts(), id)
+}
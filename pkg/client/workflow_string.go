@@ -0,0 +1,71 @@
+// Code generated by "stringer -type=WorkflowTriggerType,WorkflowTriggerSource,WorkflowActionType -output=workflow_string.go"; DO NOT EDIT.
+
+package client
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[WorkflowTriggerTypeUnspecified-0]
+	_ = x[WorkflowTriggerTypeConsumption-1]
+	_ = x[WorkflowTriggerTypeDocumentAdded-2]
+	_ = x[WorkflowTriggerTypeDocumentUpdated-3]
+	_ = x[WorkflowTriggerTypeScheduled-4]
+}
+
+const _WorkflowTriggerType_name = "WorkflowTriggerTypeUnspecifiedWorkflowTriggerTypeConsumptionWorkflowTriggerTypeDocumentAddedWorkflowTriggerTypeDocumentUpdatedWorkflowTriggerTypeScheduled"
+
+var _WorkflowTriggerType_index = [...]uint8{0, 30, 60, 92, 126, 154}
+
+func (i WorkflowTriggerType) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_WorkflowTriggerType_index)-1 {
+		return "WorkflowTriggerType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _WorkflowTriggerType_name[_WorkflowTriggerType_index[idx]:_WorkflowTriggerType_index[idx+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[WorkflowTriggerSourceUnspecified-0]
+	_ = x[WorkflowTriggerSourceConsumeFolder-1]
+	_ = x[WorkflowTriggerSourceAPIUpload-2]
+	_ = x[WorkflowTriggerSourceMailFetch-3]
+}
+
+const _WorkflowTriggerSource_name = "WorkflowTriggerSourceUnspecifiedWorkflowTriggerSourceConsumeFolderWorkflowTriggerSourceAPIUploadWorkflowTriggerSourceMailFetch"
+
+var _WorkflowTriggerSource_index = [...]uint8{0, 32, 66, 96, 126}
+
+func (i WorkflowTriggerSource) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_WorkflowTriggerSource_index)-1 {
+		return "WorkflowTriggerSource(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _WorkflowTriggerSource_name[_WorkflowTriggerSource_index[idx]:_WorkflowTriggerSource_index[idx+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[WorkflowActionTypeUnspecified-0]
+	_ = x[WorkflowActionTypeAssignment-1]
+	_ = x[WorkflowActionTypeRemoval-2]
+	_ = x[WorkflowActionTypeEmail-3]
+	_ = x[WorkflowActionTypeWebhook-4]
+}
+
+const _WorkflowActionType_name = "WorkflowActionTypeUnspecifiedWorkflowActionTypeAssignmentWorkflowActionTypeRemovalWorkflowActionTypeEmailWorkflowActionTypeWebhook"
+
+var _WorkflowActionType_index = [...]uint8{0, 29, 57, 82, 105, 130}
+
+func (i WorkflowActionType) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_WorkflowActionType_index)-1 {
+		return "WorkflowActionType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _WorkflowActionType_name[_WorkflowActionType_index[idx]:_WorkflowActionType_index[idx+1]]
+}
@@ -0,0 +1,365 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestWorkflowTriggerSource(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		want    []WorkflowTriggerSource
+		wantErr error
+	}{
+		{input: `[]`},
+		{
+			input: `[1, 2, 3]`,
+			want: []WorkflowTriggerSource{
+				WorkflowTriggerSourceConsumeFolder,
+				WorkflowTriggerSourceAPIUpload,
+				WorkflowTriggerSourceMailFetch,
+			},
+		},
+		{
+			input: `["3", "1"]`,
+			want: []WorkflowTriggerSource{
+				WorkflowTriggerSourceMailFetch,
+				WorkflowTriggerSourceConsumeFolder,
+			},
+		},
+		{input: `["x"]`, wantErr: cmpopts.AnyError},
+		{input: `[true]`, wantErr: cmpopts.AnyError},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			var got []WorkflowTriggerSource
+
+			err := json.Unmarshal([]byte(tc.input), &got)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unmarshal() error diff (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Unmarshal() diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGetWorkflow(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodGet, "/api/workflows/5/",
+		httpmock.NewStringResponder(http.StatusOK, `{
+			"id": 5,
+			"name": "Invoices",
+			"order": 1,
+			"enabled": true,
+			"triggers": [
+				{
+					"id": 8,
+					"type": 1,
+					"sources": ["1", "2"],
+					"filter_filename": "*invoice*",
+					"matching_algorithm": 0,
+					"is_insensitive": true,
+					"filter_has_tags": [],
+					"schedule_date_field": "added"
+				}
+			],
+			"actions": [
+				{
+					"id": 9,
+					"type": 1,
+					"assign_title": "{correspondent} invoice",
+					"assign_tags": [2, 3]
+				},
+				{
+					"id": 10,
+					"type": 4,
+					"webhook": {
+						"id": 2,
+						"url": "https://example.com/hook",
+						"use_params": true,
+						"params": {"doc": "{doc_url}"},
+						"headers": {"X-Test": "1"}
+					}
+				}
+			]
+		}`))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	got, _, err := c.GetWorkflow(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("GetWorkflow() failed: %v", err)
+	}
+
+	want := &Workflow{
+		ID:      5,
+		Name:    "Invoices",
+		Order:   1,
+		Enabled: true,
+		Triggers: []WorkflowTrigger{
+			{
+				ID:   8,
+				Type: WorkflowTriggerTypeConsumption,
+				Sources: []WorkflowTriggerSource{
+					WorkflowTriggerSourceConsumeFolder,
+					WorkflowTriggerSourceAPIUpload,
+				},
+				FilterFilename:    String("*invoice*"),
+				IsInsensitive:     true,
+				ScheduleDateField: WorkflowScheduleDateAdded,
+			},
+		},
+		Actions: []WorkflowAction{
+			{
+				ID:          9,
+				Type:        WorkflowActionTypeAssignment,
+				AssignTitle: String("{correspondent} invoice"),
+				AssignTags:  []int64{2, 3},
+			},
+			{
+				ID:   10,
+				Type: WorkflowActionTypeWebhook,
+				Webhook: &WorkflowActionWebhook{
+					ID:        2,
+					URL:       "https://example.com/hook",
+					UseParams: true,
+					Params:    map[string]string{"doc": "{doc_url}"},
+					Headers:   map[string]string{"X-Test": "1"},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("GetWorkflow() diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateWorkflowNewNested(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodPut, "/api/workflows/7/",
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				Triggers []map[string]any `json:"triggers"`
+				Actions  []map[string]any `json:"actions"`
+			}
+
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Decoding request body failed: %v", err)
+			}
+
+			if len(body.Triggers) != 1 || len(body.Actions) != 1 {
+				t.Fatalf("Unexpected request body: %+v", body)
+			}
+
+			if _, ok := body.Triggers[0]["id"]; !ok {
+				t.Errorf("Existing trigger must include ID: %v", body.Triggers[0])
+			}
+
+			if _, ok := body.Actions[0]["id"]; ok {
+				t.Errorf("New action must not include ID: %v", body.Actions[0])
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 7}`), nil
+		})
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	_, _, err := c.UpdateWorkflow(context.Background(), 7, &Workflow{
+		ID: 7,
+		Triggers: []WorkflowTrigger{
+			{ID: 3, Type: WorkflowTriggerTypeDocumentAdded},
+		},
+		Actions: []WorkflowAction{
+			{
+				Type: WorkflowActionTypeEmail,
+				Email: &WorkflowActionEmail{
+					Subject: "New document",
+					To:      "office@example.com",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("UpdateWorkflow() failed: %v", err)
+	}
+}
+
+func TestWorkflowNestedRequestBody(t *testing.T) {
+	trigger := WorkflowTrigger{
+		Type:    WorkflowTriggerTypeConsumption,
+		Sources: []WorkflowTriggerSource{WorkflowTriggerSourceAPIUpload},
+	}
+
+	action := WorkflowAction{
+		Type:       WorkflowActionTypeAssignment,
+		AssignTags: []int64{2},
+	}
+
+	const wantTrigger = `{
+		"type": 1,
+		"sources": [2],
+		"filter_path": null,
+		"filter_filename": null,
+		"filter_mailrule": null,
+		"matching_algorithm": 0,
+		"match": "",
+		"is_insensitive": false,
+		"filter_has_tags": [],
+		"filter_has_correspondent": null,
+		"filter_has_document_type": null,
+		"schedule_offset_days": 0,
+		"schedule_is_recurring": false,
+		"schedule_recurring_interval_days": 1,
+		"schedule_date_field": "added",
+		"schedule_date_custom_field": null
+	}`
+
+	const wantAction = `{
+		"type": 1,
+		"assign_title": null,
+		"assign_tags": [2],
+		"assign_correspondent": null,
+		"assign_document_type": null,
+		"assign_storage_path": null,
+		"assign_owner": null,
+		"assign_view_users": [],
+		"assign_view_groups": [],
+		"assign_change_users": [],
+		"assign_change_groups": [],
+		"assign_custom_fields": [],
+		"remove_tags": [],
+		"remove_all_tags": false,
+		"remove_correspondents": [],
+		"remove_all_correspondents": false,
+		"remove_document_types": [],
+		"remove_all_document_types": false,
+		"remove_storage_paths": [],
+		"remove_all_storage_paths": false,
+		"remove_custom_fields": [],
+		"remove_all_custom_fields": false,
+		"remove_owners": [],
+		"remove_all_owners": false,
+		"remove_view_users": [],
+		"remove_view_groups": [],
+		"remove_change_users": [],
+		"remove_change_groups": [],
+		"remove_all_permissions": false,
+		"email": null,
+		"webhook": null
+	}`
+
+	for _, tc := range []struct {
+		name     string
+		method   string
+		path     string
+		status   int
+		run      func(*Client) error
+		wantBody string
+	}{
+		{
+			name:   "create",
+			method: http.MethodPost,
+			path:   "/api/workflows/",
+			status: http.StatusCreated,
+			run: func(c *Client) error {
+				_, _, err := c.CreateWorkflow(context.Background(), NewWorkflowFields().
+					SetName("Uploads").
+					SetTriggers([]WorkflowTrigger{trigger}).
+					SetActions([]WorkflowAction{action}))
+
+				return err
+			},
+			wantBody: `{
+				"name": "Uploads",
+				"triggers": [` + wantTrigger + `],
+				"actions": [` + wantAction + `]
+			}`,
+		},
+		{
+			name:   "update",
+			method: http.MethodPut,
+			path:   "/api/workflows/7/",
+			status: http.StatusOK,
+			run: func(c *Client) error {
+				_, _, err := c.UpdateWorkflow(context.Background(), 7, &Workflow{
+					ID:       7,
+					Name:     "Uploads",
+					Enabled:  true,
+					Triggers: []WorkflowTrigger{trigger},
+					Actions:  []WorkflowAction{action},
+				})
+
+				return err
+			},
+			wantBody: `{
+				"id": 7,
+				"name": "Uploads",
+				"order": 0,
+				"enabled": true,
+				"triggers": [` + wantTrigger + `],
+				"actions": [` + wantAction + `]
+			}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := newMockTransport(t)
+			transport.RegisterResponder(tc.method, tc.path,
+				func(req *http.Request) (*http.Response, error) {
+					var got, want any
+
+					if err := json.NewDecoder(req.Body).Decode(&got); err != nil {
+						t.Errorf("Decoding request body failed: %v", err)
+					}
+
+					if err := json.Unmarshal([]byte(tc.wantBody), &want); err != nil {
+						t.Fatalf("Unmarshal() failed: %v", err)
+					}
+
+					// Empty lists and null must be distinguished.
+					if diff := cmp.Diff(want, got); diff != "" {
+						t.Errorf("Request body diff (-want +got):\n%s", diff)
+					}
+
+					return httpmock.NewStringResponse(tc.status, `{"id": 7}`), nil
+				})
+
+			c := New(Options{
+				transport: transport,
+			})
+
+			if err := tc.run(c); err != nil {
+				t.Errorf("Request failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteWorkflowAction(t *testing.T) {
+	transport := newMockTransport(t)
+	transport.RegisterResponder(http.MethodDelete, "/api/workflow_actions/12/",
+		httpmock.NewStringResponder(http.StatusNoContent, ``))
+
+	c := New(Options{
+		transport: transport,
+	})
+
+	if _, err := c.DeleteWorkflowAction(context.Background(), 12); err != nil {
+		t.Errorf("DeleteWorkflowAction() failed: %v", err)
+	}
+}
@@ -24,6 +24,14 @@ func RegisterClient(g FlagGroup, f *client.Flags) {
 		PlaceHolder("NUM").
 		IntVar(&f.MaxConcurrentRequests)
 
+	b.flag("paperless_max_requests_per_second", "Number of requests per second sent at most. Defaults to zero (disabled).").
+		PlaceHolder("NUM").
+		Float64Var(&f.MaxRequestsPerSecond)
+
+	b.flag("paperless_max_retries", "Number of times a failed idempotent request is retried. Defaults to zero (disabled).").
+		PlaceHolder("NUM").
+		IntVar(&f.MaxRetries)
+
 	b.flag("paperless_auth_token", "Authentication token for Paperless. Reading the token from a file is preferable.").
 		PlaceHolder("TOKEN").
 		StringVar(&f.AuthToken)
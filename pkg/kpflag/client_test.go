@@ -93,6 +93,24 @@ func TestRegisterClient(t *testing.T) {
 				MaxConcurrentRequests: 123,
 			},
 		},
+		{
+			name: "max requests per second",
+			args: []string{
+				"--paperless_max_requests_per_second=2.5",
+			},
+			want: client.Flags{
+				MaxRequestsPerSecond: 2.5,
+			},
+		},
+		{
+			name: "max retries",
+			env: map[string]string{
+				"PAPERLESS_MAX_RETRIES": "3",
+			},
+			want: client.Flags{
+				MaxRetries: 3,
+			},
+		},
 	} {
 		flagParseTest{
 			name: tc.name,